@@ -19,9 +19,11 @@ package core
 
 import (
 	//go:nolint
+	_ "bufio"
 	_ "bytes"
 	_ "context"
 	_ "encoding/base64"
+	_ "encoding/json"
 	_ "fmt"
 	_ "io"
 	_ "log"
@@ -29,6 +31,7 @@ import (
 	_ "math/rand"
 	_ "net"
 	_ "os"
+	_ "path"
 	_ "path/filepath"
 	_ "reflect"
 	_ "regexp"
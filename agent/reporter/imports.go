@@ -28,6 +28,7 @@ import (
 	_ "os"
 	_ "runtime"
 	_ "runtime/pprof"
+	_ "sort"
 	_ "strconv"
 	_ "strings"
 	_ "sync"
@@ -0,0 +1,131 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type testOperationInfo struct {
+	headers map[string]string
+}
+
+func (i *testOperationInfo) Resource() string {
+	return "orders"
+}
+
+func (i *testOperationInfo) Inject(key, value string) error {
+	i.headers[key] = value
+	return nil
+}
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestOperationInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	info := &testOperationInfo{headers: make(map[string]string)}
+	tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, info)
+
+	interceptor := &OperationInterceptor{}
+	invocation := operator.NewInvocation(awsmiddleware.RegisterServiceMetadata{
+		ServiceID: "SQS", Region: "us-east-1", OperationName: "SendMessage",
+	}, context.Background(), middleware.InitializeInput{}, nil)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, tracing.GetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey), "operation info should be consumed")
+	assert.NotEmpty(t, info.headers["sw8"], "context should be injected into the carrier")
+
+	assert.Nil(t, interceptor.AfterInvoke(invocation, middleware.InitializeOutput{},
+		testMetadata(t, "http://localstack:4566/000000000000/orders"), nil))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "SQS/SendMessage", spans[0].OperationName())
+	assert.Equal(t, "localstack:4566", spans[0].Peer(), "peer should be the real endpoint")
+	assert.Equal(t, awsComponentID, spans[0].ComponentID())
+	assert.Equal(t, "us-east-1", tagValue(spans[0], tagAWSRegion))
+	assert.Equal(t, "orders", tagValue(spans[0], string(tracing.TagMQQueue)))
+	assert.False(t, spans[0].IsError())
+}
+
+func TestOperationInterceptorWithError(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	interceptor := &OperationInterceptor{}
+	invocation := operator.NewInvocation(awsmiddleware.RegisterServiceMetadata{
+		ServiceID: "DynamoDB", Region: "eu-west-1", OperationName: "GetItem",
+	}, context.Background(), middleware.InitializeInput{}, nil)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, interceptor.AfterInvoke(invocation, middleware.InitializeOutput{}, middleware.Metadata{},
+		errors.New("operation error DynamoDB: GetItem, dial tcp: connection refused")))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "DynamoDB/GetItem", spans[0].OperationName())
+	assert.Equal(t, "dynamodb.eu-west-1.amazonaws.com", spans[0].Peer(), "peer should fall back to the default endpoint")
+	assert.Equal(t, "DynamoDB", tagValue(spans[0], string(tracing.TagDBType)))
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+}
+
+func TestDefaultPeer(t *testing.T) {
+	assert.Equal(t, "s3.us-east-1.amazonaws.com", defaultPeer(awsmiddleware.RegisterServiceMetadata{ServiceID: "S3", Region: "us-east-1"}))
+	assert.Equal(t, "secretsmanager.amazonaws.com", defaultPeer(awsmiddleware.RegisterServiceMetadata{ServiceID: "Secrets Manager"}))
+}
+
+// testMetadata builds the metadata as the SDK does, which records the raw response of the operation.
+func testMetadata(t *testing.T, address string) middleware.Metadata {
+	parsed, err := url.Parse(address)
+	assert.Nil(t, err)
+	_, metadata, err := awsmiddleware.AddRawResponse{}.HandleDeserialize(context.Background(), middleware.DeserializeInput{},
+		middleware.DeserializeHandlerFunc(func(context.Context, middleware.DeserializeInput) (
+			middleware.DeserializeOutput, middleware.Metadata, error) {
+			return middleware.DeserializeOutput{
+				RawResponse: &smithyhttp.Response{Response: &http.Response{Request: &http.Request{URL: parsed}}},
+			}, middleware.Metadata{}, nil
+		}))
+	assert.Nil(t, err)
+	return metadata
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
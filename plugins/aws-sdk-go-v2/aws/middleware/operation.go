@@ -0,0 +1,152 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	// the SDK calls the services through HTTP, reuse the HTTP client component
+	awsComponentID   int32 = 5005
+	tagAWSRegion           = "aws.region"
+	tagS3Bucket            = "aws.s3.bucket"
+	tagDynamoDBTable       = "aws.dynamodb.table"
+
+	serviceIDS3       = "S3"
+	serviceIDDynamoDB = "DynamoDB"
+	serviceIDSQS      = "SQS"
+	serviceIDSNS      = "SNS"
+)
+
+// OperationInfo is provided by the service plugins through the runtime context,
+// which describes the resource that the operation works on, such as the bucket, table, queue or topic name.
+type OperationInfo interface {
+	Resource() string
+}
+
+// OperationPeer is optional for the OperationInfo, when the address of the resource is known before the
+// operation is sent, such as the queue URL of SQS.
+type OperationPeer interface {
+	Peer() string
+}
+
+// OperationCarrier is optional for the OperationInfo, the tracing context is injected into it,
+// such as the message attributes of SQS.
+type OperationCarrier interface {
+	Inject(key, value string) error
+}
+
+// OperationInterceptor creates the exit span for every operation of the services.
+type OperationInterceptor struct {
+}
+
+func (o *OperationInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	info := tracing.GetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey)
+	if info != nil {
+		tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, nil)
+	}
+	metadata, ok := invocation.CallerInstance().(awsmiddleware.RegisterServiceMetadata)
+	if !ok || metadata.ServiceID == "" || metadata.OperationName == "" {
+		return nil
+	}
+
+	resource := ""
+	if operation, ok := info.(OperationInfo); ok {
+		resource = operation.Resource()
+	}
+	opts := []tracing.SpanOption{tracing.WithComponent(awsComponentID)}
+	switch metadata.ServiceID {
+	case serviceIDS3:
+		opts = append(opts, tracing.WithLayer(tracing.SpanLayerHTTP))
+		opts = appendResourceTag(opts, tagS3Bucket, resource)
+	case serviceIDDynamoDB:
+		opts = append(opts, tracing.WithLayer(tracing.SpanLayerDatabase), tracing.WithTag(tracing.TagDBType, serviceIDDynamoDB))
+		opts = appendResourceTag(opts, tagDynamoDBTable, resource)
+	case serviceIDSQS:
+		opts = append(opts, tracing.WithLayer(tracing.SpanLayerMQ))
+		opts = appendResourceTag(opts, tracing.TagMQQueue, resource)
+	case serviceIDSNS:
+		opts = append(opts, tracing.WithLayer(tracing.SpanLayerMQ))
+		opts = appendResourceTag(opts, tracing.TagMQTopic, resource)
+	default:
+		opts = append(opts, tracing.WithLayer(tracing.SpanLayerHTTP))
+	}
+	if metadata.Region != "" {
+		opts = append(opts, tracing.WithTag(tagAWSRegion, metadata.Region))
+	}
+	injector := func(key, value string) error {
+		return nil
+	}
+	if carrier, ok := info.(OperationCarrier); ok {
+		injector = carrier.Inject
+	}
+
+	peer := defaultPeer(metadata)
+	if operation, ok := info.(OperationPeer); ok && operation.Peer() != "" {
+		peer = operation.Peer()
+	}
+	span, err := tracing.CreateExitSpan(metadata.ServiceID+"/"+metadata.OperationName, peer, injector, opts...)
+	if err != nil {
+		return err
+	}
+	invocation.SetContext(span)
+	return nil
+}
+
+func (o *OperationInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	span, ok := invocation.GetContext().(tracing.Span)
+	if !ok {
+		return nil
+	}
+	// the endpoint is resolved inside the middleware stack, so the real peer comes from the response
+	if metadata, ok := result[1].(smithymiddleware.Metadata); ok {
+		if resp, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok &&
+			resp != nil && resp.Response != nil && resp.Request != nil && resp.Request.URL != nil {
+			span.SetPeer(resp.Request.URL.Host)
+		}
+	}
+	if err, ok := result[2].(error); ok && err != nil {
+		span.Error(err.Error())
+	}
+	span.End()
+	return nil
+}
+
+func appendResourceTag(opts []tracing.SpanOption, key tracing.Tag, resource string) []tracing.SpanOption {
+	if resource == "" {
+		return opts
+	}
+	return append(opts, tracing.WithTag(key, resource))
+}
+
+// defaultPeer is the default endpoint of the service, used until the real endpoint is known from the response.
+func defaultPeer(metadata awsmiddleware.RegisterServiceMetadata) string {
+	service := strings.ToLower(strings.ReplaceAll(metadata.ServiceID, " ", ""))
+	if metadata.Region == "" {
+		return service + ".amazonaws.com"
+	}
+	return service + "." + metadata.Region + ".amazonaws.com"
+}
@@ -0,0 +1,106 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dynamodb
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// operationInfo describes the tables of the operation.
+type operationInfo struct {
+	table string
+}
+
+func (i *operationInfo) Resource() string {
+	return i.table
+}
+
+// InvokeOperationInterceptor provides the tables of the operation to the operation span.
+type InvokeOperationInterceptor struct {
+}
+
+func (i *InvokeOperationInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	table := operationTable(invocation.Args()[2])
+	if table == "" {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, &operationInfo{table: table})
+	invocation.SetContext(table)
+	return nil
+}
+
+func (i *InvokeOperationInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() != nil {
+		tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, nil)
+	}
+	return nil
+}
+
+func operationTable(params interface{}) string {
+	switch input := params.(type) {
+	case *dynamodb.GetItemInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.PutItemInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.UpdateItemInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.DeleteItemInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.QueryInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.ScanInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.CreateTableInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.DeleteTableInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.DescribeTableInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.UpdateTableInput:
+		return aws.ToString(input.TableName)
+	case *dynamodb.BatchGetItemInput:
+		tables := make([]string, 0, len(input.RequestItems))
+		for table := range input.RequestItems {
+			tables = append(tables, table)
+		}
+		return joinTables(tables)
+	case *dynamodb.BatchWriteItemInput:
+		tables := make([]string, 0, len(input.RequestItems))
+		for table := range input.RequestItems {
+			tables = append(tables, table)
+		}
+		return joinTables(tables)
+	}
+	return ""
+}
+
+// joinTables joins the tables in order, so the tag is stable for the same tables.
+func joinTables(tables []string) string {
+	for i := 1; i < len(tables); i++ {
+		for j := i; j > 0 && tables[j] < tables[j-1]; j-- {
+			tables[j], tables[j-1] = tables[j-1], tables[j]
+		}
+	}
+	return strings.Join(tables, ",")
+}
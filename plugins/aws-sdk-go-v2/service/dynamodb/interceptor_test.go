@@ -0,0 +1,58 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dynamodb
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestInvokeOperationInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	interceptor := &InvokeOperationInterceptor{}
+	invocation := operator.NewInvocation(nil, nil, "GetItem", &dynamodb.GetItemInput{TableName: aws.String("users")}, nil, nil)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	info, ok := tracing.GetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey).(*operationInfo)
+	assert.True(t, ok, "operation info should be provided to the operation span")
+	assert.Equal(t, "users", info.Resource())
+
+	assert.Nil(t, interceptor.AfterInvoke(invocation, &dynamodb.GetItemOutput{}, nil, nil))
+	assert.Nil(t, tracing.GetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey), "operation info should be cleaned")
+}
+
+func TestOperationTable(t *testing.T) {
+	assert.Equal(t, "orders", operationTable(&dynamodb.QueryInput{TableName: aws.String("orders")}))
+	assert.Equal(t, "orders,users", operationTable(&dynamodb.BatchWriteItemInput{RequestItems: map[string][]types.WriteRequest{
+		"users":  nil,
+		"orders": nil,
+	}}))
+	assert.Equal(t, "", operationTable(&dynamodb.ListTablesInput{}))
+}
@@ -0,0 +1,92 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// operationInfo describes the bucket of the operation.
+type operationInfo struct {
+	bucket string
+}
+
+func (i *operationInfo) Resource() string {
+	return i.bucket
+}
+
+// InvokeOperationInterceptor provides the bucket of the operation to the operation span.
+type InvokeOperationInterceptor struct {
+}
+
+func (i *InvokeOperationInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	bucket := operationBucket(invocation.Args()[2])
+	if bucket == "" {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, &operationInfo{bucket: bucket})
+	invocation.SetContext(bucket)
+	return nil
+}
+
+func (i *InvokeOperationInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() != nil {
+		tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, nil)
+	}
+	return nil
+}
+
+func operationBucket(params interface{}) string {
+	switch input := params.(type) {
+	case *s3.GetObjectInput:
+		return aws.ToString(input.Bucket)
+	case *s3.PutObjectInput:
+		return aws.ToString(input.Bucket)
+	case *s3.DeleteObjectInput:
+		return aws.ToString(input.Bucket)
+	case *s3.DeleteObjectsInput:
+		return aws.ToString(input.Bucket)
+	case *s3.HeadObjectInput:
+		return aws.ToString(input.Bucket)
+	case *s3.CopyObjectInput:
+		return aws.ToString(input.Bucket)
+	case *s3.ListObjectsInput:
+		return aws.ToString(input.Bucket)
+	case *s3.ListObjectsV2Input:
+		return aws.ToString(input.Bucket)
+	case *s3.CreateBucketInput:
+		return aws.ToString(input.Bucket)
+	case *s3.DeleteBucketInput:
+		return aws.ToString(input.Bucket)
+	case *s3.HeadBucketInput:
+		return aws.ToString(input.Bucket)
+	case *s3.CreateMultipartUploadInput:
+		return aws.ToString(input.Bucket)
+	case *s3.UploadPartInput:
+		return aws.ToString(input.Bucket)
+	case *s3.CompleteMultipartUploadInput:
+		return aws.ToString(input.Bucket)
+	case *s3.AbortMultipartUploadInput:
+		return aws.ToString(input.Bucket)
+	}
+	return ""
+}
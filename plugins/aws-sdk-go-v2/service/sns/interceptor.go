@@ -0,0 +1,86 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sns
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// operationInfo describes the topic of the operation.
+type operationInfo struct {
+	topic string
+}
+
+func (i *operationInfo) Resource() string {
+	return i.topic
+}
+
+// InvokeOperationInterceptor provides the topic of the operation to the operation span.
+type InvokeOperationInterceptor struct {
+}
+
+func (i *InvokeOperationInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	topic := operationTopic(invocation.Args()[2])
+	if topic == "" {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, &operationInfo{topic: topic})
+	invocation.SetContext(topic)
+	return nil
+}
+
+func (i *InvokeOperationInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() != nil {
+		tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, nil)
+	}
+	return nil
+}
+
+func operationTopic(params interface{}) string {
+	switch input := params.(type) {
+	case *sns.PublishInput:
+		if input.TopicArn != nil {
+			return topicName(*input.TopicArn)
+		}
+		return topicName(aws.ToString(input.TargetArn))
+	case *sns.CreateTopicInput:
+		return aws.ToString(input.Name)
+	case *sns.DeleteTopicInput:
+		return topicName(aws.ToString(input.TopicArn))
+	case *sns.SubscribeInput:
+		return topicName(aws.ToString(input.TopicArn))
+	case *sns.GetTopicAttributesInput:
+		return topicName(aws.ToString(input.TopicArn))
+	case *sns.SetTopicAttributesInput:
+		return topicName(aws.ToString(input.TopicArn))
+	case *sns.ListSubscriptionsByTopicInput:
+		return topicName(aws.ToString(input.TopicArn))
+	}
+	return ""
+}
+
+// topicName is the last part of the topic ARN, such as "arn:aws:sns:us-east-1:123456789012:orders".
+func topicName(arn string) string {
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
@@ -0,0 +1,33 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sns
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperationTopic(t *testing.T) {
+	assert.Equal(t, "orders", operationTopic(&sns.PublishInput{TopicArn: aws.String("arn:aws:sns:us-east-1:000000000000:orders")}))
+	assert.Equal(t, "orders", operationTopic(&sns.PublishInput{TargetArn: aws.String("arn:aws:sns:us-east-1:000000000000:orders")}))
+	assert.Equal(t, "orders", operationTopic(&sns.CreateTopicInput{Name: aws.String("orders")}))
+	assert.Equal(t, "", operationTopic(&sns.ListTopicsInput{}))
+}
@@ -0,0 +1,256 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sqs
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	// the same HTTP client component as the operation spans of the SDK
+	sqsComponentID    int32 = 5005
+	sqsPrefix               = "SQS/"
+	sqsConsumerSuffix       = "/Consumer"
+
+	// SQS allows at most 10 attributes in a message
+	maxMessageAttributes = 10
+	attributeDataType    = "String"
+	headerSW8            = "sw8"
+	headerCorrelation    = "sw8-correlation"
+	allAttributeNames    = "All"
+	allAttributeNamesExp = ".*"
+)
+
+// operationInfo describes the queue of the operation, and the message attributes carrying the tracing context.
+type operationInfo struct {
+	queue      string
+	host       string
+	receive    bool
+	attributes []*map[string]types.MessageAttributeValue
+}
+
+func (i *operationInfo) Resource() string {
+	return i.queue
+}
+
+func (i *operationInfo) Peer() string {
+	return i.host
+}
+
+func (i *operationInfo) Inject(key, value string) error {
+	for _, attributes := range i.attributes {
+		if *attributes == nil {
+			*attributes = make(map[string]types.MessageAttributeValue)
+		}
+		if _, exist := (*attributes)[key]; !exist && len(*attributes) >= maxMessageAttributes {
+			continue
+		}
+		(*attributes)[key] = types.MessageAttributeValue{
+			DataType:    aws.String(attributeDataType),
+			StringValue: aws.String(value),
+		}
+	}
+	return nil
+}
+
+// InvokeOperationInterceptor provides the queue of the operation to the operation span,
+// injects the tracing context into the sent messages and continues it from the received messages.
+type InvokeOperationInterceptor struct {
+}
+
+func (i *InvokeOperationInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	info := buildOperationInfo(invocation)
+	if info == nil {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, info)
+	invocation.SetContext(info)
+	return nil
+}
+
+func (i *InvokeOperationInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	info, ok := invocation.GetContext().(*operationInfo)
+	if !ok {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey, nil)
+	if err, ok := result[2].(error); ok && err != nil {
+		return nil
+	}
+	if output, ok := result[0].(*sqs.ReceiveMessageOutput); ok && info.receive && output != nil {
+		return traceReceivedMessages(info, output.Messages)
+	}
+	return nil
+}
+
+// buildOperationInfo reads the queue from the input, the input is copied when it needs to be changed,
+// so the input of the user would not be modified.
+func buildOperationInfo(invocation operator.Invocation) *operationInfo {
+	switch input := invocation.Args()[2].(type) {
+	case *sqs.SendMessageInput:
+		copied := *input
+		copied.MessageAttributes = copyAttributes(input.MessageAttributes)
+		invocation.ChangeArg(2, &copied)
+		return newOperationInfo(copied.QueueUrl, &copied.MessageAttributes)
+	case *sqs.SendMessageBatchInput:
+		copied := *input
+		copied.Entries = make([]types.SendMessageBatchRequestEntry, len(input.Entries))
+		attributes := make([]*map[string]types.MessageAttributeValue, 0, len(input.Entries))
+		for inx := range input.Entries {
+			copied.Entries[inx] = input.Entries[inx]
+			copied.Entries[inx].MessageAttributes = copyAttributes(input.Entries[inx].MessageAttributes)
+			attributes = append(attributes, &copied.Entries[inx].MessageAttributes)
+		}
+		invocation.ChangeArg(2, &copied)
+		return newOperationInfo(copied.QueueUrl, attributes...)
+	case *sqs.ReceiveMessageInput:
+		copied := *input
+		copied.MessageAttributeNames = withPropagationAttributeNames(input.MessageAttributeNames)
+		invocation.ChangeArg(2, &copied)
+		info := newOperationInfo(copied.QueueUrl)
+		info.receive = true
+		return info
+	case *sqs.DeleteMessageInput:
+		return newOperationInfo(input.QueueUrl)
+	case *sqs.DeleteMessageBatchInput:
+		return newOperationInfo(input.QueueUrl)
+	case *sqs.ChangeMessageVisibilityInput:
+		return newOperationInfo(input.QueueUrl)
+	case *sqs.ChangeMessageVisibilityBatchInput:
+		return newOperationInfo(input.QueueUrl)
+	case *sqs.GetQueueAttributesInput:
+		return newOperationInfo(input.QueueUrl)
+	case *sqs.SetQueueAttributesInput:
+		return newOperationInfo(input.QueueUrl)
+	case *sqs.PurgeQueueInput:
+		return newOperationInfo(input.QueueUrl)
+	case *sqs.DeleteQueueInput:
+		return newOperationInfo(input.QueueUrl)
+	case *sqs.CreateQueueInput:
+		return &operationInfo{queue: aws.ToString(input.QueueName)}
+	case *sqs.GetQueueUrlInput:
+		return &operationInfo{queue: aws.ToString(input.QueueName)}
+	}
+	return nil
+}
+
+func newOperationInfo(queueURL *string, attributes ...*map[string]types.MessageAttributeValue) *operationInfo {
+	address := aws.ToString(queueURL)
+	info := &operationInfo{
+		queue:      address[strings.LastIndex(address, "/")+1:],
+		attributes: attributes,
+	}
+	if parsed, err := url.Parse(address); err == nil {
+		info.host = parsed.Host
+	}
+	return info
+}
+
+func copyAttributes(attributes map[string]types.MessageAttributeValue) map[string]types.MessageAttributeValue {
+	if attributes == nil {
+		return nil
+	}
+	result := make(map[string]types.MessageAttributeValue, len(attributes)+2)
+	for k, v := range attributes {
+		result[k] = v
+	}
+	return result
+}
+
+// withPropagationAttributeNames makes sure the attributes carrying the tracing context are received.
+func withPropagationAttributeNames(names []string) []string {
+	result := make([]string, 0, len(names)+2)
+	var hasSW8, hasCorrelation bool
+	for _, name := range names {
+		switch name {
+		case allAttributeNames, allAttributeNamesExp:
+			return names
+		case headerSW8:
+			hasSW8 = true
+		case headerCorrelation:
+			hasCorrelation = true
+		}
+		result = append(result, name)
+	}
+	if !hasSW8 {
+		result = append(result, headerSW8)
+	}
+	if !hasCorrelation {
+		result = append(result, headerCorrelation)
+	}
+	return result
+}
+
+// traceReceivedMessages continues the tracing context from the received messages. When receiving in the entry span
+// of a traced request, the messages are linked to it, otherwise one entry span is created for the whole batch,
+// such as receiving in a local span of the worker loop, as only the entry span could carry the references.
+func traceReceivedMessages(info *operationInfo, messages []types.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	if isEntrySpan(tracing.ActiveSpan()) {
+		for inx := range messages {
+			// a broken header on a single message must not break others, so the error is intentionally ignored
+			_ = tracing.ExtractContext(messageExtractor(&messages[inx]))
+		}
+		return nil
+	}
+	span, err := tracing.CreateEntrySpan(sqsPrefix+info.queue+sqsConsumerSuffix, messageExtractor(&messages[0]),
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(sqsComponentID),
+		tracing.WithTag(tracing.TagMQQueue, info.queue),
+	)
+	if err != nil {
+		return err
+	}
+	for inx := 1; inx < len(messages); inx++ {
+		_ = tracing.ExtractContext(messageExtractor(&messages[inx]))
+	}
+	if info.host != "" {
+		span.Tag(tracing.TagMQBroker, info.host)
+		span.SetPeer(info.host)
+	}
+	span.End()
+	return nil
+}
+
+func isEntrySpan(span tracing.Span) bool {
+	wrapper, ok := span.(*tracing.SpanWrapper)
+	if !ok || wrapper == nil {
+		return false
+	}
+	entry, ok := wrapper.Span.(interface{ IsEntry() bool })
+	return ok && entry.IsEntry()
+}
+
+func messageExtractor(message *types.Message) tracing.Extractor {
+	return func(headerKey string) (string, error) {
+		if value, ok := message.MessageAttributes[headerKey]; ok {
+			return aws.ToString(value.StringValue), nil
+		}
+		return "", nil
+	}
+}
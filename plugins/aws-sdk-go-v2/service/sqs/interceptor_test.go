@@ -0,0 +1,215 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sqs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const testQueueURL = "http://localstack:4566/000000000000/orders"
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestSendMessage(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	input := &sqs.SendMessageInput{
+		QueueUrl:    aws.String(testQueueURL),
+		MessageBody: aws.String("hello"),
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"biz": {DataType: aws.String("String"), StringValue: aws.String("order")},
+		},
+	}
+	interceptor := &InvokeOperationInterceptor{}
+	invocation := operator.NewInvocation(nil, nil, "SendMessage", input, nil, nil)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+
+	info, ok := tracing.GetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey).(*operationInfo)
+	assert.True(t, ok, "operation info should be provided to the operation span")
+	assert.Equal(t, "orders", info.Resource())
+	assert.Equal(t, "localstack:4566", info.Peer())
+
+	span, err := tracing.CreateExitSpan("SQS/SendMessage", "localstack:4566", info.Inject)
+	assert.Nil(t, err)
+	span.End()
+
+	sent := invocation.Args()[2].(*sqs.SendMessageInput)
+	assert.NotSame(t, input, sent, "the input of the user should not be modified")
+	assert.Equal(t, 1, len(input.MessageAttributes))
+	assert.Equal(t, "order", aws.ToString(sent.MessageAttributes["biz"].StringValue))
+	assert.NotEmpty(t, aws.ToString(sent.MessageAttributes[headerSW8].StringValue))
+
+	assert.Nil(t, interceptor.AfterInvoke(invocation, &sqs.SendMessageOutput{}, nil, nil))
+	assert.Nil(t, tracing.GetRuntimeContextValue(tracing.AWSOperationInfoRuntimeContextKey), "operation info should be cleaned")
+}
+
+func TestSendMessageBatchWithFullAttributes(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	full := make(map[string]types.MessageAttributeValue, maxMessageAttributes)
+	for i := 0; i < maxMessageAttributes; i++ {
+		full[string(rune('a'+i))] = types.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String("v")}
+	}
+	input := &sqs.SendMessageBatchInput{
+		QueueUrl: aws.String(testQueueURL),
+		Entries: []types.SendMessageBatchRequestEntry{
+			{Id: aws.String("1"), MessageBody: aws.String("first")},
+			{Id: aws.String("2"), MessageBody: aws.String("second"), MessageAttributes: full},
+		},
+	}
+	invocation := operator.NewInvocation(nil, nil, "SendMessageBatch", input, nil, nil)
+	info := buildOperationInfo(invocation)
+	assert.Nil(t, info.Inject(headerSW8, "context"))
+
+	sent := invocation.Args()[2].(*sqs.SendMessageBatchInput)
+	assert.Nil(t, input.Entries[0].MessageAttributes, "the input of the user should not be modified")
+	assert.Equal(t, "context", aws.ToString(sent.Entries[0].MessageAttributes[headerSW8].StringValue))
+	assert.Equal(t, maxMessageAttributes, len(sent.Entries[1].MessageAttributes), "attributes should not exceed the limit")
+}
+
+func TestWithPropagationAttributeNames(t *testing.T) {
+	assert.Equal(t, []string{headerSW8, headerCorrelation}, withPropagationAttributeNames(nil))
+	assert.Equal(t, []string{"biz", headerSW8, headerCorrelation}, withPropagationAttributeNames([]string{"biz", headerSW8}))
+	assert.Equal(t, []string{allAttributeNames}, withPropagationAttributeNames([]string{allAttributeNames}))
+}
+
+func TestReceiveMessage(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	headers := make(map[string]types.MessageAttributeValue)
+	span, err := tracing.CreateExitSpan("SQS/SendMessage", "localstack:4566", func(key, value string) error {
+		headers[key] = types.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(value)}
+		return nil
+	})
+	assert.Nil(t, err)
+	span.End()
+	tracing.CleanContext()
+
+	input := &sqs.ReceiveMessageInput{QueueUrl: aws.String(testQueueURL)}
+	interceptor := &InvokeOperationInterceptor{}
+	invocation := operator.NewInvocation(nil, nil, "ReceiveMessage", input, nil, nil)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, input.MessageAttributeNames, "the input of the user should not be modified")
+	assert.Equal(t, []string{headerSW8, headerCorrelation}, invocation.Args()[2].(*sqs.ReceiveMessageInput).MessageAttributeNames)
+
+	output := &sqs.ReceiveMessageOutput{Messages: []types.Message{
+		{MessageId: aws.String("1"), MessageAttributes: headers},
+		{MessageId: aws.String("2")},
+	}}
+	assert.Nil(t, interceptor.AfterInvoke(invocation, output, nil, nil))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 2, len(spans), "spans length should be 2")
+	consumer := spans[1]
+	assert.Equal(t, "SQS/orders/Consumer", consumer.OperationName())
+	assert.Equal(t, "localstack:4566", consumer.Peer())
+	assert.Equal(t, sqsComponentID, consumer.ComponentID())
+	assert.Equal(t, "orders", tagValue(consumer, string(tracing.TagMQQueue)))
+	assert.Equal(t, 1, len(consumer.Refs()), "the consumer should continue the context of the sent message")
+	assert.Equal(t, spans[0].Context().GetTraceID(), consumer.Refs()[0].GetTraceID())
+}
+
+func sentMessageHeaders(t *testing.T) (traceID string, headers map[string]types.MessageAttributeValue) {
+	headers = make(map[string]types.MessageAttributeValue)
+	span, err := tracing.CreateExitSpan("SQS/SendMessage", "localstack:4566", func(key, value string) error {
+		headers[key] = types.MessageAttributeValue{DataType: aws.String("String"), StringValue: aws.String(value)}
+		return nil
+	})
+	assert.Nil(t, err)
+	traceID = span.TraceID()
+	span.End()
+	tracing.CleanContext()
+	// the ids generated by a new tracing context in the same millisecond are the same in the test
+	time.Sleep(2 * time.Millisecond)
+	return traceID, headers
+}
+
+func TestReceiveMessageInLocalSpan(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	producerTraceID, headers := sentMessageHeaders(t)
+	worker, err := tracing.CreateLocalSpan("worker")
+	assert.Nil(t, err)
+	messages := []types.Message{{MessageId: aws.String("1"), MessageAttributes: headers}}
+	assert.Nil(t, traceReceivedMessages(&operationInfo{queue: "orders"}, messages))
+	worker.End()
+
+	time.Sleep(100 * time.Millisecond)
+	var consumer reporter.ReportedSpan
+	for _, span := range core.GetReportedSpans() {
+		if span.OperationName() == "SQS/orders/Consumer" {
+			consumer = span
+		}
+	}
+	if !assert.NotNil(t, consumer, "the consumer span should be created in the local span") {
+		return
+	}
+	assert.Equal(t, worker.SpanID(), consumer.Context().GetParentSpanID())
+	assert.Equal(t, 1, len(consumer.Refs()), "the consumer should continue the context of the sent message")
+	assert.Equal(t, producerTraceID, consumer.Refs()[0].GetTraceID())
+}
+
+func TestReceiveMessageInEntrySpan(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	producerTraceID, headers := sentMessageHeaders(t)
+	entry, err := tracing.CreateEntrySpan("GET:/receive", func(headerKey string) (string, error) {
+		return "", nil
+	})
+	assert.Nil(t, err)
+	messages := []types.Message{{MessageId: aws.String("1"), MessageAttributes: headers}}
+	assert.Nil(t, traceReceivedMessages(&operationInfo{queue: "orders"}, messages))
+	entry.End()
+
+	time.Sleep(100 * time.Millisecond)
+	var receiver reporter.ReportedSpan
+	for _, span := range core.GetReportedSpans() {
+		assert.NotEqual(t, "SQS/orders/Consumer", span.OperationName(), "no more entry span should be created")
+		if span.OperationName() == "GET:/receive" {
+			receiver = span
+		}
+	}
+	if !assert.NotNil(t, receiver) {
+		return
+	}
+	assert.Equal(t, 1, len(receiver.Refs()), "the entry span should continue the context of the received message")
+	assert.Equal(t, producerTraceID, receiver.Refs()[0].GetTraceID())
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
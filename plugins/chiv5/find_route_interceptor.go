@@ -0,0 +1,73 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package chiv5
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type FindRouteInterceptor struct {
+}
+
+func (f *FindRouteInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (f *FindRouteInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	// only process with matched route
+	if handler, ok := results[2].(http.Handler); !ok || handler == nil {
+		return nil
+	}
+	rctx, ok := invocation.Args()[0].(*chi.Context)
+	// the route method is only set when routing the request, skip the manual matching such as Mux.Match
+	if !ok || rctx == nil || rctx.RouteMethod == "" {
+		return nil
+	}
+
+	span := tracing.ActiveSpan()
+	if span == nil {
+		return nil
+	}
+
+	// the pattern contains the patterns of all mounted sub routers which are already matched
+	if pattern := rctx.RoutePattern(); pattern != "" {
+		span.SetOperationName(rctx.RouteMethod + ":" + pattern)
+	}
+	if config.CollectRouteParams && len(rctx.URLParams.Keys) > 0 {
+		span.Tag(tracing.TagHTTPRouteParams, formatRouteParams(rctx.URLParams.Keys, rctx.URLParams.Values))
+	}
+	return nil
+}
+
+func formatRouteParams(keys, values []string) string {
+	params := make([]string, 0, len(keys))
+	for i, key := range keys {
+		// the wildcard param of the mounted sub router is reset to empty when routing into it
+		if i >= len(values) || (key == "*" && values[i] == "") {
+			continue
+		}
+		params = append(params, key+"="+values[i])
+	}
+	return strings.Join(params, "&")
+}
@@ -0,0 +1,97 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package chiv5
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestFindRouteRenameEntrySpan(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectRouteParams = true
+	defer func() {
+		config.CollectRouteParams = false
+	}()
+
+	rctx := chi.NewRouteContext()
+	rctx.RouteMethod = http.MethodGet
+	rctx.RoutePatterns = []string{"/api/*", "/users/{id}"}
+	rctx.URLParams.Add("*", "")
+	rctx.URLParams.Add("id", "1")
+
+	findRoute(t, rctx)
+
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GET:/api/users/{id}", spans[0].OperationName(), "operation name should be the route pattern")
+	assert.Equal(t, "id=1", tagValue(spans[0], tracing.TagHTTPRouteParams), "route params should be collected")
+}
+
+func TestFindRouteSkipManualMatching(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	rctx := chi.NewRouteContext()
+	rctx.RoutePatterns = []string{"/users/{id}"}
+	rctx.URLParams.Add("id", "1")
+
+	findRoute(t, rctx)
+
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GET:/users/1", spans[0].OperationName(), "operation name should not be changed")
+	assert.Equal(t, "", tagValue(spans[0], tracing.TagHTTPRouteParams), "route params should not be collected")
+}
+
+func findRoute(t *testing.T, rctx *chi.Context) {
+	span, err := tracing.CreateEntrySpan("GET:/users/1", func(headerKey string) (string, error) {
+		return "", nil
+	})
+	assert.Nil(t, err, "create entry span error should be nil")
+
+	interceptor := &FindRouteInterceptor{}
+	invocation := operator.NewInvocation(nil, rctx, nil, "/users/1")
+	err = interceptor.AfterInvoke(invocation, nil, nil, http.NotFoundHandler())
+	assert.Nil(t, err, "after invoke error should be nil")
+
+	span.End()
+	time.Sleep(100 * time.Millisecond)
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,78 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package chiv5
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "chiv5"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "github.com/go-chi/chi/v5"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return strings.HasPrefix(version, "v5.")
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		{
+			PackageName: "chi",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*Mux", "ServeHTTP",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "http.ResponseWriter"), instrument.WithArgType(1, "*http.Request"),
+				instrument.WithResultCount(0)),
+			Interceptor: "ServeHTTPInterceptor",
+		},
+		{
+			PackageName: "chi",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*node", "FindRoute",
+				instrument.WithArgsCount(3),
+				instrument.WithArgType(0, "*Context"),
+				instrument.WithArgType(1, "methodTyp"),
+				instrument.WithArgType(2, "string"),
+				instrument.WithResultCount(3),
+				instrument.WithResultType(2, "http.Handler")),
+			Interceptor: "FindRouteInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
@@ -0,0 +1,83 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package chiv5
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServeHTTPCreateEntrySpan(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	request := httptest.NewRequest(http.MethodGet, "http://localhost/users/1", http.NoBody)
+	interceptor := &ServeHTTPInterceptor{}
+	invocation := operator.NewInvocation(nil, httptest.NewRecorder(), request)
+	err := interceptor.BeforeInvoke(invocation)
+	assert.Nil(t, err, "before invoke error should be nil")
+
+	rctx := chi.NewRouteContext()
+	rctx.RouteMethod = http.MethodGet
+	rctx.RoutePatterns = []string{"/users/{id}"}
+	err = (&FindRouteInterceptor{}).AfterInvoke(operator.NewInvocation(nil, rctx, nil, "/users/1"), nil, nil, http.NotFoundHandler())
+	assert.Nil(t, err, "find route error should be nil")
+
+	invocation.Args()[0].(http.ResponseWriter).WriteHeader(http.StatusNotFound)
+	err = interceptor.AfterInvoke(invocation)
+	assert.Nil(t, err, "after invoke error should be nil")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GET:/users/{id}", spans[0].OperationName(), "operation name should be the route pattern")
+	assert.Equal(t, int32(5004), spans[0].ComponentID(), "component id should be the go http server")
+	assert.Equal(t, "404", tagValue(spans[0], tracing.TagStatusCode), "status code should be collected")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+}
+
+func TestServeHTTPReuseActiveSpan(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	span, err := tracing.CreateEntrySpan("GET:/users/1", func(headerKey string) (string, error) {
+		return "", nil
+	})
+	assert.Nil(t, err, "create entry span error should be nil")
+
+	recorder := httptest.NewRecorder()
+	interceptor := &ServeHTTPInterceptor{}
+	invocation := operator.NewInvocation(nil, recorder, httptest.NewRequest(http.MethodGet, "http://localhost/users/1", http.NoBody))
+	err = interceptor.BeforeInvoke(invocation)
+	assert.Nil(t, err, "before invoke error should be nil")
+	assert.Equal(t, recorder, invocation.Args()[0], "writer should not be wrapped")
+	err = interceptor.AfterInvoke(invocation)
+	assert.Nil(t, err, "after invoke error should be nil")
+	span.End()
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, len(core.GetReportedSpans()), "spans length should be 1")
+}
@@ -0,0 +1,77 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package core
+
+import (
+	"strconv"
+	"strings"
+)
+
+const logContextLayoutSplit = "split"
+
+// LogContextLayout defines how the tracing context is injected into the logs
+type LogContextLayout struct {
+	// inject each part of the context into the separate fields, otherwise the whole context is in the single field
+	Split        bool
+	ServiceKey   string
+	InstanceKey  string
+	TraceIDKey   string
+	SegmentIDKey string
+	SpanIDKey    string
+	// the template of the context string, such as "[{service},{trace_id}]"
+	Template string
+}
+
+func NewLogContextLayout(layout, serviceKey, instanceKey, traceIDKey, segmentIDKey, spanIDKey, template string) *LogContextLayout {
+	return &LogContextLayout{
+		Split:        strings.EqualFold(layout, logContextLayoutSplit),
+		ServiceKey:   serviceKey,
+		InstanceKey:  instanceKey,
+		TraceIDKey:   traceIDKey,
+		SegmentIDKey: segmentIDKey,
+		SpanIDKey:    spanIDKey,
+		Template:     template,
+	}
+}
+
+// fields generate the key and value pairs of the context, the fields with empty key are ignored
+func (l *LogContextLayout) fields(ctx *SkyWalkingLogContext) []string {
+	result := make([]string, 0, 10)
+	for _, kv := range [][2]string{
+		{l.ServiceKey, ctx.ServiceName},
+		{l.InstanceKey, ctx.InstanceName},
+		{l.TraceIDKey, ctx.TraceID},
+		{l.SegmentIDKey, ctx.TraceSegmentID},
+		{l.SpanIDKey, strconv.FormatInt(int64(ctx.SpanID), 10)},
+	} {
+		if kv[0] != "" {
+			result = append(result, kv[0], kv[1])
+		}
+	}
+	return result
+}
+
+func (l *LogContextLayout) format(ctx *SkyWalkingLogContext) string {
+	return strings.NewReplacer(
+		"{service}", ctx.ServiceName,
+		"{instance}", ctx.InstanceName,
+		"{trace_id}", ctx.TraceID,
+		"{segment_id}", ctx.TraceSegmentID,
+		"{span_id}", strconv.FormatInt(int64(ctx.SpanID), 10),
+	).Replace(l.Template)
+}
@@ -0,0 +1,207 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package core
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/skywalking-go/plugins/core/metrics"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+)
+
+const (
+	logLevelTrace int32 = iota
+	logLevelDebug
+	logLevelInfo
+	logLevelWarn
+	logLevelError
+	logLevelPanic
+	logLevelFatal
+)
+
+const (
+	logDroppedByLevel     = "level"
+	logDroppedByUnsampled = "unsampled"
+	logDroppedByRateLimit = "rate_limit"
+)
+
+// LogReportLimiter decides whether the log should be reported to the backend,
+// all the configurations could be changed by the Configuration Discovery Service
+type LogReportLimiter struct {
+	minLevel    atomic.Int32
+	rateLimit   atomic.Int64
+	sampledOnly atomic.Bool
+	alwaysWarn  atomic.Bool
+
+	// the reported count in the current second
+	currentSecond atomic.Int64
+	currentCount  atomic.Int64
+
+	droppedCounters map[string]metrics.Counter
+}
+
+func NewLogReportLimiter(minLevel, rateLimit, sampledOnly, alwaysWarn string, tracer *Tracer) *LogReportLimiter {
+	l := &LogReportLimiter{droppedCounters: make(map[string]metrics.Counter)}
+	for _, reason := range []string{logDroppedByLevel, logDroppedByUnsampled, logDroppedByRateLimit} {
+		l.droppedCounters[reason] = tracer.NewCounter("sw_go_log_dropped_counter", &metrics.Opts{
+			Labels: map[string]string{"reason": reason},
+		}).(metrics.Counter)
+	}
+	// append watchers
+	tracer.cdsWatchers = append(tracer.cdsWatchers,
+		newLogReporterConfigWatcher("log.reporter.min_level", minLevel, l.updateMinLevel),
+		newLogReporterConfigWatcher("log.reporter.rate_limit", rateLimit, l.updateRateLimit),
+		newLogReporterConfigWatcher("log.reporter.sampled_only", sampledOnly, l.updateSampledOnly),
+		newLogReporterConfigWatcher("log.reporter.always_warn", alwaysWarn, l.updateAlwaysWarn),
+	)
+	return l
+}
+
+// Allow the log could be reported or not, the dropped log is counted by the reason
+func (l *LogReportLimiter) Allow(ctx logTracingContext, level string) bool {
+	logLevel, _ := parseLogLevel(level)
+	if logLevel < l.minLevel.Load() {
+		l.droppedCounters[logDroppedByLevel].Inc(1)
+		return false
+	}
+	if l.sampledOnly.Load() && ctx.GetTraceID() == noopContextValue &&
+		(logLevel < logLevelWarn || !l.alwaysWarn.Load()) {
+		l.droppedCounters[logDroppedByUnsampled].Inc(1)
+		return false
+	}
+	if !l.acquire() {
+		l.droppedCounters[logDroppedByRateLimit].Inc(1)
+		return false
+	}
+	return true
+}
+
+func (l *LogReportLimiter) acquire() bool {
+	limit := l.rateLimit.Load()
+	if limit <= 0 {
+		return true
+	}
+	now := time.Now().Unix()
+	if second := l.currentSecond.Load(); second != now && l.currentSecond.CompareAndSwap(second, now) {
+		l.currentCount.Store(0)
+	}
+	return l.currentCount.Add(1) <= limit
+}
+
+func (l *LogReportLimiter) updateMinLevel(value string) bool {
+	if value == "" {
+		l.minLevel.Store(logLevelTrace)
+		return true
+	}
+	level, ok := parseLogLevel(value)
+	if !ok {
+		return false
+	}
+	l.minLevel.Store(level)
+	return true
+}
+
+func (l *LogReportLimiter) updateRateLimit(value string) bool {
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false
+	}
+	l.rateLimit.Store(limit)
+	return true
+}
+
+func (l *LogReportLimiter) updateSampledOnly(value string) bool {
+	sampledOnly, err := strconv.ParseBool(value)
+	if err != nil {
+		return false
+	}
+	l.sampledOnly.Store(sampledOnly)
+	return true
+}
+
+func (l *LogReportLimiter) updateAlwaysWarn(value string) bool {
+	alwaysWarn, err := strconv.ParseBool(value)
+	if err != nil {
+		return false
+	}
+	l.alwaysWarn.Store(alwaysWarn)
+	return true
+}
+
+// parseLogLevel convert the level name from different logging frameworks,
+// such as "warning" of logrus, "DPANIC" of zap or "INFO+2" of slog
+func parseLogLevel(level string) (int32, bool) {
+	upper := strings.ToUpper(strings.TrimSpace(level))
+	switch {
+	case strings.HasPrefix(upper, "TRACE"):
+		return logLevelTrace, true
+	case strings.HasPrefix(upper, "DEBUG"):
+		return logLevelDebug, true
+	case strings.HasPrefix(upper, "INFO"):
+		return logLevelInfo, true
+	case strings.HasPrefix(upper, "WARN"):
+		return logLevelWarn, true
+	case strings.HasPrefix(upper, "ERR"):
+		return logLevelError, true
+	case strings.HasPrefix(upper, "PANIC"), strings.HasPrefix(upper, "DPANIC"):
+		return logLevelPanic, true
+	case strings.HasPrefix(upper, "FATAL"):
+		return logLevelFatal, true
+	}
+	return logLevelInfo, false
+}
+
+type logReporterConfigWatcher struct {
+	key          string
+	defaultValue string
+	currentValue string
+	update       func(value string) bool
+	locker       sync.RWMutex
+}
+
+func newLogReporterConfigWatcher(key, defaultValue string, update func(value string) bool) *logReporterConfigWatcher {
+	w := &logReporterConfigWatcher{key: key, defaultValue: defaultValue, update: update}
+	w.Notify(reporter.MODIFY, defaultValue)
+	return w
+}
+
+func (w *logReporterConfigWatcher) Key() string {
+	return w.key
+}
+
+func (w *logReporterConfigWatcher) Notify(eventType reporter.AgentConfigEventType, newValue string) {
+	if eventType == reporter.DELETED {
+		newValue = w.defaultValue
+	}
+	if !w.update(newValue) {
+		return
+	}
+	w.locker.Lock()
+	defer w.locker.Unlock()
+	w.currentValue = newValue
+}
+
+func (w *logReporterConfigWatcher) Value() string {
+	w.locker.RLock()
+	defer w.locker.RUnlock()
+	return w.currentValue
+}
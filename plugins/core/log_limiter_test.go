@@ -0,0 +1,121 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	sampledLogContext   = &SkyWalkingLogContext{TraceID: "trace-id"}
+	unsampledLogContext = &SkyWalkingLogContext{TraceID: noopContextValue}
+)
+
+func TestParseLogLevel(t *testing.T) {
+	tests := []struct {
+		level    string
+		expected int32
+		valid    bool
+	}{
+		{"trace", logLevelTrace, true},
+		{"DEBUG-4", logLevelDebug, true},
+		{"info", logLevelInfo, true},
+		{"INFO+2", logLevelInfo, true},
+		{"warning", logLevelWarn, true},
+		{"WARN", logLevelWarn, true},
+		{"error", logLevelError, true},
+		{"dpanic", logLevelPanic, true},
+		{"PANIC", logLevelPanic, true},
+		{"fatal", logLevelFatal, true},
+		{"unknown", logLevelInfo, false},
+	}
+	for _, tt := range tests {
+		level, valid := parseLogLevel(tt.level)
+		assert.Equal(t, tt.expected, level, tt.level)
+		assert.Equal(t, tt.valid, valid, tt.level)
+	}
+}
+
+func TestLogReportLimiterLevel(t *testing.T) {
+	defer ResetTracingContext()
+	limiter := NewLogReportLimiter("WARN", "0", "false", "true", Tracing)
+	assert.False(t, limiter.Allow(sampledLogContext, "info"))
+	assert.True(t, limiter.Allow(sampledLogContext, "warning"))
+	assert.True(t, limiter.Allow(sampledLogContext, "ERROR"))
+	assert.Equal(t, float64(1), limiter.droppedCounters[logDroppedByLevel].Get())
+}
+
+func TestLogReportLimiterSampledOnly(t *testing.T) {
+	defer ResetTracingContext()
+	limiter := NewLogReportLimiter("", "0", "true", "true", Tracing)
+	assert.True(t, limiter.Allow(sampledLogContext, "debug"))
+	assert.False(t, limiter.Allow(unsampledLogContext, "info"))
+	assert.True(t, limiter.Allow(unsampledLogContext, "warn"))
+
+	limiter.updateAlwaysWarn("false")
+	assert.False(t, limiter.Allow(unsampledLogContext, "error"))
+	assert.Equal(t, float64(2), limiter.droppedCounters[logDroppedByUnsampled].Get())
+}
+
+func TestLogReportLimiterRateLimit(t *testing.T) {
+	defer ResetTracingContext()
+	limiter := NewLogReportLimiter("", "2", "false", "true", Tracing)
+	allowed := 0
+	for i := 0; i < 5; i++ {
+		if limiter.Allow(sampledLogContext, "info") {
+			allowed++
+		}
+	}
+	// the logs may cross the second boundary
+	assert.GreaterOrEqual(t, allowed, 2)
+	assert.LessOrEqual(t, allowed, 4)
+	assert.Equal(t, float64(5-allowed), limiter.droppedCounters[logDroppedByRateLimit].Get())
+}
+
+func TestLogReportLimiterDynamicConfig(t *testing.T) {
+	defer ResetTracingContext()
+	Tracing.cdsWatchers = nil
+	limiter := NewLogReportLimiter("", "0", "false", "true", Tracing)
+	watchers := make(map[string]reporter.AgentConfigChangeWatcher)
+	for _, w := range Tracing.cdsWatchers {
+		watchers[w.Key()] = w
+	}
+	assert.Len(t, watchers, 4)
+
+	minLevel := watchers["log.reporter.min_level"]
+	minLevel.Notify(reporter.MODIFY, "ERROR")
+	assert.Equal(t, "ERROR", minLevel.Value())
+	assert.False(t, limiter.Allow(sampledLogContext, "warn"))
+
+	// the invalid value should be ignored
+	minLevel.Notify(reporter.MODIFY, "unknown")
+	assert.Equal(t, "ERROR", minLevel.Value())
+	assert.False(t, limiter.Allow(sampledLogContext, "warn"))
+
+	// back to the default value when deleted
+	minLevel.Notify(reporter.DELETED, "")
+	assert.Equal(t, "", minLevel.Value())
+	assert.True(t, limiter.Allow(sampledLogContext, "warn"))
+
+	watchers["log.reporter.sampled_only"].Notify(reporter.MODIFY, "true")
+	assert.False(t, limiter.Allow(unsampledLogContext, "info"))
+}
@@ -18,6 +18,7 @@
 package core
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"time"
@@ -43,7 +44,79 @@ type logTracingContext interface {
 
 var noopContext = &NoopSpan{}
 
+const (
+	structuredLogMessageKey = "message"
+	structuredLogStackKey   = "stack"
+)
+
 func (t *Tracer) ReportLog(ctx, timeObj interface{}, level, msg string, labels map[string]string) {
+	if !t.allowReportLog(ctx, level) {
+		return
+	}
+	t.reportLog(ctx, timeObj, level, labels, textLogBody(msg))
+}
+
+// ReportStructuredLog report the log with the JSON body, which contains the message and all the structured fields
+func (t *Tracer) ReportStructuredLog(ctx, timeObj interface{}, level, msg string, labels map[string]string,
+	fields map[string]interface{}) {
+	if !t.allowReportLog(ctx, level) {
+		return
+	}
+	body := make(map[string]interface{}, len(fields)+1)
+	for k, v := range fields {
+		body[k] = t.structuredLogValue(v)
+	}
+	body[structuredLogMessageKey] = msg
+	content, err := json.Marshal(body)
+	if err != nil {
+		t.reportLog(ctx, timeObj, level, labels, textLogBody(msg))
+		return
+	}
+	t.reportLog(ctx, timeObj, level, labels, &logv3.LogDataBody{
+		Type: "JSON",
+		Content: &logv3.LogDataBody_Json{
+			Json: &logv3.JSONLog{Json: string(content)},
+		},
+	})
+}
+
+// GetLogLabelKeys get the keys of the fields which should be reported as the labels of the log
+func (t *Tracer) GetLogLabelKeys() []string {
+	return t.logLabelKeys
+}
+
+// IsLogJSONBody check whether the logs of the framework should be reported with the JSON body
+func (t *Tracer) IsLogJSONBody(framework string) bool {
+	for _, f := range t.logJSONBodyFrameworks {
+		if f == framework {
+			return true
+		}
+	}
+	return false
+}
+
+func textLogBody(msg string) *logv3.LogDataBody {
+	return &logv3.LogDataBody{
+		Type: "TEXT",
+		Content: &logv3.LogDataBody_Text{
+			Text: &logv3.TextLog{Text: msg},
+		},
+	}
+}
+
+// allowReportLog check the log by the level threshold, trace sampling and rate limit
+func (t *Tracer) allowReportLog(ctx interface{}, level string) bool {
+	tracingContext, ok := ctx.(logTracingContext)
+	if !ok || tracingContext == nil {
+		return false
+	}
+	if t.logLimiter == nil {
+		return true
+	}
+	return t.logLimiter.Allow(tracingContext, level)
+}
+
+func (t *Tracer) reportLog(ctx, timeObj interface{}, level string, labels map[string]string, body *logv3.LogDataBody) {
 	tracingContext, ok := ctx.(logTracingContext)
 	if !ok || tracingContext == nil {
 		return
@@ -78,12 +151,7 @@ func (t *Tracer) ReportLog(ctx, timeObj interface{}, level, msg string, labels m
 		Service:         tracingContext.GetServiceName(),
 		ServiceInstance: tracingContext.GetInstanceName(),
 		Endpoint:        tracingContext.GetEndPointName(),
-		Body: &logv3.LogDataBody{
-			Type: "TEXT",
-			Content: &logv3.LogDataBody_Text{
-				Text: &logv3.TextLog{Text: msg},
-			},
-		},
+		Body:            body,
 		TraceContext: &logv3.TraceContext{
 			TraceId:        tracingContext.GetTraceID(),
 			TraceSegmentId: tracingContext.GetTraceSegmentID(),
@@ -96,7 +164,54 @@ func (t *Tracer) ReportLog(ctx, timeObj interface{}, level, msg string, labels m
 	t.Reporter.SendLog(logData)
 }
 
+// structuredLogValue keeps the type of the field value when it could be serialized as JSON,
+// the errors are serialized with the message and optional stack, others are formatted as string
+func (t *Tracer) structuredLogValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case nil, string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, time.Time:
+		return val
+	case error:
+		result := map[string]interface{}{structuredLogMessageKey: val.Error()}
+		if t.logErrorStack {
+			if stack := fmt.Sprintf("%+v", val); stack != val.Error() {
+				result[structuredLogStackKey] = stack
+			}
+		}
+		return result
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(val))
+		for k, sub := range val {
+			result[k] = t.structuredLogValue(sub)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(val))
+		for _, sub := range val {
+			result = append(result, t.structuredLogValue(sub))
+		}
+		return result
+	}
+	if _, err := json.Marshal(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return v
+}
+
 func (t *Tracer) GetLogContext(withEndpoint bool) interface{} {
+	s, _ := t.ActiveSpan().(TracingSpan)
+	return t.buildLogContext(s, withEndpoint)
+}
+
+// GetLogContextBySnapshot get the log context of the span in the captured tracing context
+func (t *Tracer) GetLogContextBySnapshot(snapshot interface{}, withEndpoint bool) interface{} {
+	var span TracingSpan
+	if snap, ok := snapshot.(*ContextSnapshot); ok && snap != nil {
+		span = snap.activeSpan
+	}
+	return t.buildLogContext(span, withEndpoint)
+}
+
+func (t *Tracer) buildLogContext(span TracingSpan, withEndpoint bool) interface{} {
 	var (
 		serviceName  string
 		instanceName string
@@ -105,10 +220,10 @@ func (t *Tracer) GetLogContext(withEndpoint bool) interface{} {
 		activeSpan TracingSpan = noopContext
 	)
 
-	if s, ok := t.ActiveSpan().(TracingSpan); ok && s != nil {
-		activeSpan = s
+	if span != nil {
+		activeSpan = span
 		if withEndpoint {
-			endpoint = findEndpointNameBySpan(s)
+			endpoint = findEndpointNameBySpan(span)
 		}
 	}
 	entity := t.Entity()
@@ -122,6 +237,7 @@ func (t *Tracer) GetLogContext(withEndpoint bool) interface{} {
 		TraceSegmentID: activeSpan.GetSegmentID(),
 		SpanID:         activeSpan.GetSpanID(),
 		EndPoint:       endpoint,
+		Layout:         t.logContextLayout,
 	}
 }
 
@@ -143,6 +259,7 @@ type SkyWalkingLogContext struct {
 	EndPoint       string
 	TraceSegmentID string
 	SpanID         int32
+	Layout         *LogContextLayout
 }
 
 func (s *SkyWalkingLogContext) GetServiceName() string {
@@ -169,7 +286,18 @@ func (s *SkyWalkingLogContext) GetEndPointName() string {
 	return s.EndPoint
 }
 
+// GetContextFields get the key and value pairs of the context when the layout is split into multiple fields
+func (s *SkyWalkingLogContext) GetContextFields() []string {
+	if s.Layout == nil || !s.Layout.Split {
+		return nil
+	}
+	return s.Layout.fields(s)
+}
+
 func (s *SkyWalkingLogContext) String() string {
+	if s.Layout != nil && s.Layout.Template != "" {
+		return s.Layout.format(s)
+	}
 	return fmt.Sprintf("[%s,%s,%s,%s,%d]", s.ServiceName, s.InstanceName,
 		s.TraceID, s.TraceSegmentID, s.SpanID)
 }
@@ -18,8 +18,11 @@
 package core
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/apache/skywalking-go/plugins/core/reporter"
 
@@ -78,3 +81,71 @@ func TestGetLogContextString(t *testing.T) {
 	assert.True(t, ok, "span should be root span")
 	rootSpan.End()
 }
+
+type stackError struct {
+	msg string
+}
+
+func (e *stackError) Error() string {
+	return e.msg
+}
+
+func (e *stackError) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('+') {
+		_, _ = fmt.Fprintf(s, "%s\nmain.handler\n\tmain.go:10", e.msg)
+		return
+	}
+	_, _ = fmt.Fprint(s, e.msg)
+}
+
+func TestReportStructuredLog(t *testing.T) {
+	defer ResetTracingContext()
+	Tracing.logErrorStack = true
+	fields := map[string]interface{}{
+		"count":   2,
+		"ok":      true,
+		"error":   &stackError{msg: "boom"},
+		"plain":   errors.New("plain"),
+		"nested":  map[string]interface{}{"ratio": 0.5},
+		"channel": make(chan int),
+		"message": "overwritten",
+	}
+	Tracing.ReportStructuredLog(Tracing.GetLogContext(false), time.Now(), "INFO", "test message",
+		map[string]string{"module": "test"}, fields)
+
+	logs := Tracing.Reporter.(*StoreReporter).Logs
+	assert.Equal(t, 1, len(logs))
+	assert.Equal(t, "JSON", logs[0].Body.Type)
+	body := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal([]byte(logs[0].Body.GetJson().GetJson()), &body))
+	assert.Equal(t, "test message", body["message"])
+	assert.Equal(t, float64(2), body["count"])
+	assert.Equal(t, true, body["ok"])
+	assert.Equal(t, map[string]interface{}{"message": "boom", "stack": "boom\nmain.handler\n\tmain.go:10"}, body["error"])
+	assert.Equal(t, map[string]interface{}{"message": "plain"}, body["plain"])
+	assert.Equal(t, map[string]interface{}{"ratio": 0.5}, body["nested"])
+	assert.IsType(t, "", body["channel"])
+	assert.Equal(t, 2, len(logs[0].Tags.Data))
+}
+
+func TestLogContextLayout(t *testing.T) {
+	defer ResetTracingContext()
+	Tracing.ServiceEntity = &reporter.Entity{ServiceName: "test-service", ServiceInstanceName: "test-instance"}
+	s, err := Tracing.CreateLocalSpan("/test")
+	assert.Nil(t, err, "err should be nil")
+	rootSpan, ok := s.(*RootSegmentSpan)
+	assert.True(t, ok, "span should be root span")
+	defer rootSpan.End()
+	traceID := rootSpan.Context().GetTraceID()
+
+	// single layout with the default format
+	swCtx := Tracing.GetLogContext(false).(*SkyWalkingLogContext)
+	assert.Nil(t, swCtx.GetContextFields())
+	assert.Contains(t, swCtx.String(), "[test-service,test-instance,"+traceID)
+
+	Tracing.logContextLayout = NewLogContextLayout("split", "service", "", "trace_id", "", "span_id",
+		"trace_id={trace_id} service={service}")
+	swCtx = Tracing.GetLogContext(false).(*SkyWalkingLogContext)
+	assert.Equal(t, []string{"service", "test-service", "trace_id", traceID, "span_id", "0"}, swCtx.GetContextFields())
+	assert.Equal(t, "trace_id="+traceID+" service=test-service", swCtx.String())
+}
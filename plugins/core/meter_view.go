@@ -0,0 +1,385 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package core
+
+import (
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+)
+
+// MeterView customizes the meters before reporting, it only applies to the meters whose name matches the pattern
+type MeterView struct {
+	// Match is the glob pattern of the meter name, such as "instance_golang_*"
+	Match string
+	// Drop the matched meters
+	Drop bool
+	// Rename the matched meters
+	Rename string
+	// ExcludeLabels removes the label keys from the matched meters
+	ExcludeLabels []string
+	// IncludeLabels only keeps the label keys in the matched meters, all labels are kept when empty
+	IncludeLabels []string
+	// Buckets overrides the buckets of the matched histograms, the counts are merged into the new buckets
+	Buckets []float64
+	// GaugeAggregation is how the matched gauges are merged after the labels changed, supports "sum", "max" and "min".
+	// The labels of the gauges are not changed when it is empty, since summing the gauges such as the heap size
+	// is meaningless
+	GaugeAggregation string
+}
+
+const (
+	gaugeAggregationSum = "sum"
+	gaugeAggregationMax = "max"
+	gaugeAggregationMin = "min"
+)
+
+// NewMeterView creates the view from the configuration, the labels and buckets are split by ","
+func NewMeterView(match, drop, rename, excludeLabels, includeLabels, buckets, gaugeAggregation string) *MeterView {
+	view := &MeterView{
+		Match:         match,
+		Drop:          strings.EqualFold(drop, "true"),
+		Rename:        rename,
+		ExcludeLabels: splitViewValues(excludeLabels),
+		IncludeLabels: splitViewValues(includeLabels),
+	}
+	switch aggregation := strings.ToLower(strings.TrimSpace(gaugeAggregation)); aggregation {
+	case gaugeAggregationSum, gaugeAggregationMax, gaugeAggregationMin:
+		view.GaugeAggregation = aggregation
+	}
+	for _, b := range splitViewValues(buckets) {
+		if v, err := strconv.ParseFloat(b, 64); err == nil {
+			view.Buckets = append(view.Buckets, v)
+		}
+	}
+	sort.Float64s(view.Buckets)
+	return view
+}
+
+func (v *MeterView) matches(name string) bool {
+	if v.Match == "" {
+		return false
+	}
+	matched, err := path.Match(v.Match, name)
+	return err == nil && matched
+}
+
+func (v *MeterView) changeLabels(labels map[string]string) map[string]string {
+	if len(v.ExcludeLabels) == 0 && len(v.IncludeLabels) == 0 {
+		return labels
+	}
+	result := make(map[string]string, len(labels))
+	for k, val := range labels {
+		if len(v.IncludeLabels) > 0 && !containsViewValue(v.IncludeLabels, k) {
+			continue
+		}
+		if containsViewValue(v.ExcludeLabels, k) {
+			continue
+		}
+		result[k] = val
+	}
+	return result
+}
+
+// applyMeterViews customizes the meters by the views, the counters and histograms have the same name and labels
+// after changed would be merged together, the gauges are merged by the aggregation of the view.
+// The meters could not be merged(such as renaming a gauge to an existing one) are conflicted, only the first one is kept
+func (t *Tracer) applyMeterViews(meters []reporter.ReportedMeter) []reporter.ReportedMeter {
+	if len(t.meterViews) == 0 {
+		return meters
+	}
+	result := make([]reporter.ReportedMeter, 0, len(meters))
+	viewMeters := make(map[string]int)
+	for _, meter := range meters {
+		changed, ok := t.applyMeterView(meter)
+		if !ok {
+			continue
+		}
+
+		key := viewMeterKey(changed)
+		inx, exist := viewMeters[key]
+		if !exist {
+			viewMeters[key] = len(result)
+			result = append(result, changed)
+			continue
+		}
+		// copy the meter not changed by the views before merging, the original meter should not be changed
+		merged := copyViewMeter(result[inx])
+		if merged == nil || !mergeViewMeter(merged, changed) {
+			t.logMeterViewConflict(changed)
+			continue
+		}
+		result[inx] = merged
+	}
+	return result
+}
+
+// logMeterViewConflict logs the conflicted meter once, the meters are collected periodically
+func (t *Tracer) logMeterViewConflict(meter reporter.ReportedMeter) {
+	key := viewMeterKey(meter)
+	if t.meterViewConflicts == nil {
+		t.meterViewConflicts = make(map[string]bool)
+	}
+	if t.meterViewConflicts[key] {
+		return
+	}
+	t.meterViewConflicts[key] = true
+	t.Log.Warnf("the meter %s with labels %v conflicts with another meter after applying the meter views, "+
+		"only the first one is reported", meter.Name(), meter.Labels())
+}
+
+// applyMeterView returns the changed meter, return false if the meter is dropped
+func (t *Tracer) applyMeterView(meter reporter.ReportedMeter) (reporter.ReportedMeter, bool) {
+	var result reporter.ReportedMeter = meter
+	for _, view := range t.meterViews {
+		if !view.matches(meter.Name()) {
+			continue
+		}
+		if view.Drop {
+			return nil, false
+		}
+		result = copyViewMeter(result)
+		if result == nil {
+			t.Log.Errorf("unknown meter type: %T", meter)
+			return nil, false
+		}
+		switch m := result.(type) {
+		case *viewSingleValueMeter:
+			if view.Rename != "" {
+				m.name = view.Rename
+			}
+			if !m.gauge {
+				m.labels = view.changeLabels(m.labels)
+			} else if view.GaugeAggregation != "" {
+				m.labels = view.changeLabels(m.labels)
+				m.aggregation = view.GaugeAggregation
+			}
+		case *viewHistogramMeter:
+			if view.Rename != "" {
+				m.name = view.Rename
+			}
+			m.labels = view.changeLabels(m.labels)
+			if len(view.Buckets) > 0 {
+				m.rebucket(view.Buckets)
+			}
+		}
+	}
+	return result, true
+}
+
+type viewSingleValueMeter struct {
+	name   string
+	labels map[string]string
+	value  float64
+	// the gauge could only be merged by the aggregation
+	gauge       bool
+	aggregation string
+}
+
+func (v *viewSingleValueMeter) Name() string {
+	return v.name
+}
+
+func (v *viewSingleValueMeter) Labels() map[string]string {
+	return v.labels
+}
+
+func (v *viewSingleValueMeter) Value() float64 {
+	return v.value
+}
+
+type viewHistogramMeter struct {
+	name    string
+	labels  map[string]string
+	buckets []*viewHistogramBucket
+}
+
+func (v *viewHistogramMeter) Name() string {
+	return v.name
+}
+
+func (v *viewHistogramMeter) Labels() map[string]string {
+	return v.labels
+}
+
+func (v *viewHistogramMeter) BucketValues() []reporter.ReportedMeterBucketValue {
+	values := make([]reporter.ReportedMeterBucketValue, 0, len(v.buckets))
+	for _, b := range v.buckets {
+		values = append(values, b)
+	}
+	return values
+}
+
+// rebucket merges the counts into the new buckets, the count is moved into the bucket which contains its lower bound.
+// The counts of the negative infinity bucket and lower than the first bucket are kept in a negative infinity bucket
+func (v *viewHistogramMeter) rebucket(buckets []float64) {
+	result := make([]*viewHistogramBucket, len(buckets))
+	for i, b := range buckets {
+		result[i] = &viewHistogramBucket{bucket: b}
+	}
+	var negativeInfinity *viewHistogramBucket
+	for _, b := range v.buckets {
+		inx := sort.Search(len(buckets), func(i int) bool {
+			return buckets[i] > b.bucket
+		}) - 1
+		if b.negativeInfinity || inx < 0 {
+			if negativeInfinity == nil {
+				negativeInfinity = &viewHistogramBucket{bucket: math.Inf(-1), negativeInfinity: true}
+			}
+			negativeInfinity.count += b.count
+			continue
+		}
+		result[inx].count += b.count
+	}
+	if negativeInfinity != nil {
+		result = append([]*viewHistogramBucket{negativeInfinity}, result...)
+	}
+	v.buckets = result
+}
+
+type viewHistogramBucket struct {
+	bucket           float64
+	count            int64
+	negativeInfinity bool
+}
+
+func (b *viewHistogramBucket) Bucket() float64 {
+	return b.bucket
+}
+
+func (b *viewHistogramBucket) Count() int64 {
+	return b.count
+}
+
+func (b *viewHistogramBucket) IsNegativeInfinity() bool {
+	return b.negativeInfinity
+}
+
+// copyViewMeter snapshots the meter, so the changes would not affect the original meter
+func copyViewMeter(meter reporter.ReportedMeter) reporter.ReportedMeter {
+	switch m := meter.(type) {
+	case *viewSingleValueMeter, *viewHistogramMeter:
+		return m
+	case reporter.ReportedMeterSingleValue:
+		_, isCounter := m.(*counterImpl)
+		return &viewSingleValueMeter{name: m.Name(), labels: m.Labels(), value: m.Value(), gauge: !isCounter}
+	case reporter.ReportedMeterHistogram:
+		values := m.BucketValues()
+		buckets := make([]*viewHistogramBucket, 0, len(values))
+		for _, b := range values {
+			buckets = append(buckets, &viewHistogramBucket{
+				bucket: b.Bucket(), count: b.Count(), negativeInfinity: b.IsNegativeInfinity()})
+		}
+		return &viewHistogramMeter{name: m.Name(), labels: m.Labels(), buckets: buckets}
+	}
+	return nil
+}
+
+// mergeViewMeter merges the meter into another one, return false if they could not be merged
+func mergeViewMeter(to, from reporter.ReportedMeter) bool {
+	switch m := to.(type) {
+	case *viewSingleValueMeter:
+		f, ok := from.(*viewSingleValueMeter)
+		if !ok || f.gauge != m.gauge {
+			return false
+		}
+		if !m.gauge {
+			m.value += f.value
+			return true
+		}
+		switch m.aggregation {
+		case gaugeAggregationSum:
+			m.value += f.value
+		case gaugeAggregationMax:
+			m.value = math.Max(m.value, f.value)
+		case gaugeAggregationMin:
+			m.value = math.Min(m.value, f.value)
+		default:
+			// the gauges without aggregation only conflict when renamed
+			return false
+		}
+		return true
+	case *viewHistogramMeter:
+		f, ok := from.(*viewHistogramMeter)
+		if !ok {
+			return false
+		}
+		for _, fb := range f.buckets {
+			merged := false
+			for _, b := range m.buckets {
+				if b.bucket == fb.bucket && b.negativeInfinity == fb.negativeInfinity {
+					b.count += fb.count
+					merged = true
+					break
+				}
+			}
+			if !merged {
+				m.buckets = append(m.buckets, fb)
+			}
+		}
+		sort.Slice(m.buckets, func(i, j int) bool {
+			if m.buckets[i].negativeInfinity != m.buckets[j].negativeInfinity {
+				return m.buckets[i].negativeInfinity
+			}
+			return m.buckets[i].bucket < m.buckets[j].bucket
+		})
+		return true
+	}
+	return false
+}
+
+func viewMeterKey(meter reporter.ReportedMeter) string {
+	labels := meter.Labels()
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteString("=")
+		sb.WriteString(labels[k])
+		sb.WriteString(",")
+	}
+	sb.WriteString(meter.Name())
+	return sb.String()
+}
+
+func splitViewValues(val string) []string {
+	result := make([]string, 0)
+	for _, v := range strings.Split(val, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func containsViewValue(values []string, val string) bool {
+	for _, v := range values {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package core
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeterViewDropAndRename(t *testing.T) {
+	tracer := newTracer()
+	tracer.meterViews = []*MeterView{
+		NewMeterView("instance_golang_gc_*", "true", "", "", "", "", ""),
+		NewMeterView("sw_go_*", "false", "go_agent_counter", "", "", "", ""),
+	}
+	meters := tracer.applyMeterViews([]reporter.ReportedMeter{
+		newCounter("instance_golang_gc_count", nil, 1),
+		newCounter("sw_go_created_tracing_context_counter", nil, 2),
+		newGauge("instance_golang_live_goroutines_num", nil, func() float64 { return 3 }),
+	})
+	assert.Equal(t, 2, len(meters))
+	assert.Equal(t, "go_agent_counter", meters[0].Name())
+	assert.Equal(t, float64(2), meters[0].(reporter.ReportedMeterSingleValue).Value())
+	assert.Equal(t, "instance_golang_live_goroutines_num", meters[1].Name())
+}
+
+func TestMeterViewLabels(t *testing.T) {
+	tracer := newTracer()
+	tracer.meterViews = []*MeterView{
+		NewMeterView("requests", "", "", "path", "", "", ""),
+		NewMeterView("errors", "", "", "", "code", "", ""),
+	}
+	meters := tracer.applyMeterViews([]reporter.ReportedMeter{
+		newCounter("requests", map[string]string{"path": "/a", "method": "GET"}, 1),
+		newCounter("requests", map[string]string{"path": "/b", "method": "GET"}, 2),
+		newCounter("errors", map[string]string{"code": "500", "path": "/a"}, 3),
+	})
+	assert.Equal(t, 2, len(meters))
+	assert.Equal(t, map[string]string{"method": "GET"}, meters[0].Labels())
+	assert.Equal(t, float64(3), meters[0].(reporter.ReportedMeterSingleValue).Value(), "the meters should be merged")
+	assert.Equal(t, map[string]string{"code": "500"}, meters[1].Labels())
+}
+
+func TestMeterViewBuckets(t *testing.T) {
+	tracer := newTracer()
+	tracer.meterViews = []*MeterView{NewMeterView("latency", "", "", "", "", "0, 10,100", "")}
+	histogram := newHistogramFromSteps("latency", nil, 0, []float64{1, 5, 10, 50, 100, 500})
+	histogram.ObserveWithCount(0.5, 1)
+	histogram.ObserveWithCount(3, 2)
+	histogram.ObserveWithCount(20, 3)
+	histogram.ObserveWithCount(60, 4)
+	histogram.ObserveWithCount(1000, 5)
+
+	meters := tracer.applyMeterViews([]reporter.ReportedMeter{histogram})
+	assert.Equal(t, 1, len(meters))
+	values := meters[0].(reporter.ReportedMeterHistogram).BucketValues()
+	assert.Equal(t, 3, len(values))
+	expected := map[float64]int64{0: 3, 10: 7, 100: 5}
+	for _, v := range values {
+		assert.Equal(t, expected[v.Bucket()], v.Count(), "count of bucket %f", v.Bucket())
+	}
+	// the original histogram should not be changed
+	assert.Equal(t, 7, len(histogram.BucketValues()))
+}
+
+func TestMeterViewGaugeAggregation(t *testing.T) {
+	newGauges := func() []reporter.ReportedMeter {
+		return []reporter.ReportedMeter{
+			newGauge("pool_size", map[string]string{"peer": "a"}, func() float64 { return 3 }),
+			newGauge("pool_size", map[string]string{"peer": "b"}, func() float64 { return 5 }),
+		}
+	}
+
+	tracer := newTracer()
+	tracer.meterViews = []*MeterView{NewMeterView("pool_size", "", "", "peer", "", "", "")}
+	meters := tracer.applyMeterViews(newGauges())
+	assert.Equal(t, 2, len(meters), "the gauges should not be merged without aggregation")
+	assert.Equal(t, map[string]string{"peer": "a"}, meters[0].Labels())
+	assert.Equal(t, map[string]string{"peer": "b"}, meters[1].Labels())
+
+	tests := map[string]float64{"sum": 8, "MAX": 5, "min": 3}
+	for aggregation, expected := range tests {
+		tracer.meterViews = []*MeterView{NewMeterView("pool_size", "", "", "peer", "", "", aggregation)}
+		meters = tracer.applyMeterViews(newGauges())
+		if assert.Equal(t, 1, len(meters), aggregation) {
+			assert.Equal(t, map[string]string{}, meters[0].Labels())
+			assert.Equal(t, expected, meters[0].(reporter.ReportedMeterSingleValue).Value(), aggregation)
+		}
+	}
+
+	// the unknown aggregation is ignored
+	assert.Equal(t, "", NewMeterView("pool_size", "", "", "peer", "", "", "avg").GaugeAggregation)
+}
+
+func TestMeterViewBucketsNegativeInfinity(t *testing.T) {
+	tracer := newTracer()
+	tracer.meterViews = []*MeterView{NewMeterView("latency", "", "", "", "", "10,100", "")}
+	histogram := &viewHistogramMeter{name: "latency", buckets: []*viewHistogramBucket{
+		{bucket: 0, count: 2, negativeInfinity: true},
+		{bucket: 0, count: 3},
+		{bucket: 20, count: 4},
+		{bucket: 200, count: 5},
+	}}
+
+	meters := tracer.applyMeterViews([]reporter.ReportedMeter{histogram})
+	values := meters[0].(reporter.ReportedMeterHistogram).BucketValues()
+	if !assert.Equal(t, 3, len(values)) {
+		return
+	}
+	assert.True(t, values[0].IsNegativeInfinity(), "the negative infinity bucket should be kept")
+	assert.True(t, math.IsInf(values[0].Bucket(), -1))
+	assert.Equal(t, int64(5), values[0].Count(), "the counts lower than the first bucket are in the negative infinity bucket")
+	assert.Equal(t, float64(10), values[1].Bucket())
+	assert.Equal(t, int64(4), values[1].Count())
+	assert.Equal(t, float64(100), values[2].Bucket())
+	assert.Equal(t, int64(5), values[2].Count())
+}
+
+func TestMeterViewRenameConflict(t *testing.T) {
+	tracer := newTracer()
+	tracer.meterViews = []*MeterView{
+		NewMeterView("requests_v1", "", "requests", "", "", "", ""),
+		NewMeterView("heap_v1", "", "heap", "", "", "", ""),
+	}
+	counter := newCounter("requests", map[string]string{"path": "/a"}, 1)
+	meters := tracer.applyMeterViews([]reporter.ReportedMeter{
+		counter,
+		newCounter("requests_v1", map[string]string{"path": "/a"}, 2),
+		newGauge("heap", nil, func() float64 { return 3 }),
+		newGauge("heap_v1", nil, func() float64 { return 4 }),
+	})
+	if !assert.Equal(t, 2, len(meters), "the meters with the same name and labels should not be duplicated") {
+		return
+	}
+	assert.Equal(t, "requests", meters[0].Name())
+	assert.Equal(t, float64(3), meters[0].(reporter.ReportedMeterSingleValue).Value(), "the counters should be merged")
+	assert.Equal(t, float64(1), counter.Value(), "the original counter should not be changed")
+	assert.Equal(t, "heap", meters[1].Name())
+	assert.Equal(t, float64(3), meters[1].(reporter.ReportedMeterSingleValue).Value(), "the first gauge should be kept")
+	assert.True(t, tracer.meterViewConflicts[viewMeterKey(meters[1])], "the conflict should be logged")
+}
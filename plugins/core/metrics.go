@@ -37,6 +37,7 @@ func (t *Tracer) initMetricsCollect(meterCollectSecond int) {
 		return
 	}
 	collectDuration := time.Duration(meterCollectSecond) * time.Second
+	t.initProcessMetrics()
 	go func() {
 		for {
 			time.Sleep(collectDuration)
@@ -100,7 +101,7 @@ func (t *Tracer) sendMetrics() {
 		return true
 	})
 
-	t.Reporter.SendMetrics(meters)
+	t.Reporter.SendMetrics(t.applyMeterViews(meters))
 }
 
 func (t *Tracer) allMeterCollectListeners() []func() {
@@ -145,11 +146,17 @@ func (t *Tracer) AddCollectHook(f func()) {
 }
 
 func (t *Tracer) registerMetrics(name string, labels map[string]string, meter interface{}) {
+	// the labels are sorted, so the same meter always has the same key
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
 	var sb strings.Builder
-	for k, v := range labels {
+	for _, k := range keys {
 		sb.WriteString(k)
 		sb.WriteString("=")
-		sb.WriteString(v)
+		sb.WriteString(labels[k])
 		sb.WriteString(",")
 	}
 	sb.WriteString(name)
@@ -322,7 +329,7 @@ func newHistogramFromExistingBuckets(name string, labels map[string]string, buck
 		labels: labels,
 	}
 
-	result.buckets = make([]*histogramBucket, 0, len(buckets))
+	result.buckets = make([]*histogramBucket, len(buckets))
 	for i, b := range buckets {
 		bucket := b.(NoInitHistogramBucket)
 		result.buckets[i] = &histogramBucket{
@@ -0,0 +1,86 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package core
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type noInitBucket struct {
+	bucket float64
+	value  *int64
+}
+
+func (b *noInitBucket) Bucket() float64 {
+	return b.bucket
+}
+
+func (b *noInitBucket) Value() *int64 {
+	return b.value
+}
+
+func TestHistogramFromExistingBuckets(t *testing.T) {
+	// the buckets of a histogram which is created before the tracer initialized,
+	// and has been observed already
+	buckets := make([]interface{}, 0)
+	for i, b := range []float64{0, 10, 100} {
+		v := int64(i + 1)
+		buckets = append(buckets, &noInitBucket{bucket: b, value: &v})
+	}
+
+	histogram := newHistogramFromExistingBuckets("test_histogram", map[string]string{"k": "v"}, buckets)
+	assert.Equal(t, "test_histogram", histogram.Name())
+	assert.Equal(t, map[string]string{"k": "v"}, histogram.Labels())
+
+	histogram.Observe(5)
+	histogram.ObserveWithCount(150, 3)
+
+	values := histogram.BucketValues()
+	if !assert.Len(t, values, 3) {
+		return
+	}
+	expected := map[float64]int64{0: 2, 10: 2, 100: 6}
+	for _, v := range values {
+		assert.Equal(t, expected[v.Bucket()], v.Count(), "bucket %f", v.Bucket())
+	}
+	// the values should be shared with the existing buckets
+	assert.Equal(t, int64(2), *buckets[0].(*noInitBucket).value)
+}
+
+func TestRegisterMetricsWithSortedLabels(t *testing.T) {
+	tracer := &Tracer{meterMap: &sync.Map{}}
+	labels := map[string]string{"a": "1", "b": "2", "c": "3", "d": "4"}
+	for i := 0; i < 10; i++ {
+		copied := make(map[string]string, len(labels))
+		for k, v := range labels {
+			copied[k] = v
+		}
+		tracer.registerMetrics("test_meter", copied, newGauge("test_meter", copied, func() float64 { return 0 }))
+	}
+
+	count := 0
+	tracer.meterMap.Range(func(key, value interface{}) bool {
+		count++
+		assert.Equal(t, "a=1,b=2,c=3,d=4,test_meter", key)
+		return true
+	})
+	assert.Equal(t, 1, count, "the same meter should be registered only once")
+}
@@ -30,5 +30,9 @@ type LogOperator interface {
 
 type LogReporter interface {
 	ReportLog(ctx, time interface{}, level, msg string, labels map[string]string)
+	ReportStructuredLog(ctx, time interface{}, level, msg string, labels map[string]string, fields map[string]interface{})
 	GetLogContext(withEndpoint bool) interface{}
+	GetLogContextBySnapshot(snapshot interface{}, withEndpoint bool) interface{}
+	GetLogLabelKeys() []string
+	IsLogJSONBody(framework string) bool
 }
@@ -0,0 +1,391 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package core
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/metrics"
+)
+
+const (
+	procSelfDir = "/proc/self"
+
+	// the kernel exports the CPU times of "/proc/self/stat" in USER_HZ, which could only be read by sysconf(_SC_CLK_TCK)
+	// through cgo. It is assumed as 100, which is the value of all the architectures supported by Go on Linux,
+	// the CPU seconds would be wrong if the kernel is built with a different USER_HZ
+	procClockTicks = 100
+)
+
+// processMetrics collects the process level metrics as the OS sees it, such as the CPU, memory, file descriptors
+// and the cgroup limits. Only Linux provides the "/proc" filesystem, the meters are only registered
+// when their source could be read, so nothing would be reported on the other platforms.
+type processMetrics struct {
+	cpuUserSeconds      float64
+	cpuSystemSeconds    float64
+	residentMemory      float64
+	virtualMemory       float64
+	openFDs             float64
+	maxFDs              float64
+	threads             float64
+	voluntarySwitches   float64
+	involuntarySwitches float64
+
+	cgroupCPUDir          string
+	cgroupMemoryDir       string
+	cgroupV2              bool
+	cgroupCPUPeriods      float64
+	cgroupCPUThrottled    float64
+	cgroupThrottledTime   float64
+	cgroupMemoryLimit     float64
+	cgroupMemoryUsage     float64
+	cgroupCPUSupported    bool
+	cgroupMemorySupported bool
+}
+
+func (t *Tracer) initProcessMetrics() {
+	if runtime.GOOS != "linux" {
+		return
+	}
+	p := &processMetrics{}
+	p.cgroupCPUDir, p.cgroupMemoryDir, p.cgroupV2 = findCgroupDirs()
+	if !p.collect() {
+		t.Log.Warnf("cannot read the process metrics from %s, the process metrics are ignored", procSelfDir)
+		return
+	}
+
+	t.newProcessGauge("instance_golang_process_cpu_seconds_labeled", func() float64 { return p.cpuUserSeconds }, "type", "user")
+	t.newProcessGauge("instance_golang_process_cpu_seconds_labeled", func() float64 { return p.cpuSystemSeconds }, "type", "system")
+	t.newProcessGauge("instance_golang_process_memory_labeled", func() float64 { return p.residentMemory }, "type", "rss")
+	t.newProcessGauge("instance_golang_process_memory_labeled", func() float64 { return p.virtualMemory }, "type", "vms")
+	t.newProcessGauge("instance_golang_process_open_fds", func() float64 { return p.openFDs })
+	t.newProcessGauge("instance_golang_process_max_fds", func() float64 { return p.maxFDs })
+	t.newProcessGauge("instance_golang_process_threads", func() float64 { return p.threads })
+	t.newProcessGauge("instance_golang_process_context_switches_labeled",
+		func() float64 { return p.voluntarySwitches }, "type", "voluntary")
+	t.newProcessGauge("instance_golang_process_context_switches_labeled",
+		func() float64 { return p.involuntarySwitches }, "type", "involuntary")
+
+	if p.cgroupCPUSupported {
+		t.newProcessGauge("instance_golang_cgroup_cpu_periods", func() float64 { return p.cgroupCPUPeriods })
+		t.newProcessGauge("instance_golang_cgroup_cpu_throttled_periods", func() float64 { return p.cgroupCPUThrottled })
+		t.newProcessGauge("instance_golang_cgroup_cpu_throttled_seconds", func() float64 { return p.cgroupThrottledTime })
+	}
+	if p.cgroupMemorySupported {
+		// the limit is zero when the memory of the cgroup is unlimited
+		t.newProcessGauge("instance_golang_cgroup_memory_limit", func() float64 { return p.cgroupMemoryLimit })
+		t.newProcessGauge("instance_golang_cgroup_memory_usage", func() float64 { return p.cgroupMemoryUsage })
+	}
+
+	t.AddCollectHook(func() {
+		p.collect()
+	})
+}
+
+func (t *Tracer) newProcessGauge(name string, getter func() float64, labels ...string) {
+	if len(labels) == 0 {
+		t.NewGauge(name, getter, nil)
+		return
+	}
+	opts := &metrics.Opts{Labels: make(map[string]string, len(labels)/2)}
+	for i := 0; i+1 < len(labels); i += 2 {
+		opts.Labels[labels[i]] = labels[i+1]
+	}
+	t.NewGauge(name, getter, opts)
+}
+
+// collect reading all the metrics, return false if the process metrics cannot be read
+func (p *processMetrics) collect() bool {
+	stat, err := os.ReadFile(filepath.Join(procSelfDir, "stat"))
+	if err != nil {
+		return false
+	}
+	p.cpuUserSeconds, p.cpuSystemSeconds = parseProcStatCPU(string(stat))
+
+	if status, err := os.ReadFile(filepath.Join(procSelfDir, "status")); err == nil {
+		values := parseProcStatus(string(status))
+		// the memory values are in kB
+		p.residentMemory = values["VmRSS"] * 1024
+		p.virtualMemory = values["VmSize"] * 1024
+		p.threads = values["Threads"]
+		p.voluntarySwitches = values["voluntary_ctxt_switches"]
+		p.involuntarySwitches = values["nonvoluntary_ctxt_switches"]
+	}
+	if fds, err := os.ReadDir(filepath.Join(procSelfDir, "fd")); err == nil {
+		p.openFDs = float64(len(fds))
+	}
+	if limits, err := os.ReadFile(filepath.Join(procSelfDir, "limits")); err == nil {
+		p.maxFDs = parseProcMaxOpenFiles(string(limits))
+	}
+
+	p.collectCgroup()
+	return true
+}
+
+func (p *processMetrics) collectCgroup() {
+	if p.cgroupV2 {
+		if p.cgroupCPUDir == "" {
+			return
+		}
+		if cpuStat, err := os.ReadFile(filepath.Join(p.cgroupCPUDir, "cpu.stat")); err == nil {
+			values := parseKeyValues(string(cpuStat))
+			p.cgroupCPUPeriods = values["nr_periods"]
+			p.cgroupCPUThrottled = values["nr_throttled"]
+			p.cgroupThrottledTime = values["throttled_usec"] / 1e6
+			p.cgroupCPUSupported = true
+		}
+		if limit, err := os.ReadFile(filepath.Join(p.cgroupMemoryDir, "memory.max")); err == nil {
+			p.cgroupMemoryLimit = parseCgroupMemoryLimit(string(limit))
+			p.cgroupMemorySupported = true
+		}
+		if usage, err := os.ReadFile(filepath.Join(p.cgroupMemoryDir, "memory.current")); err == nil {
+			p.cgroupMemoryUsage = parseFloat(string(usage))
+		}
+		return
+	}
+
+	if p.cgroupCPUDir != "" {
+		if cpuStat, err := os.ReadFile(filepath.Join(p.cgroupCPUDir, "cpu.stat")); err == nil {
+			values := parseKeyValues(string(cpuStat))
+			p.cgroupCPUPeriods = values["nr_periods"]
+			p.cgroupCPUThrottled = values["nr_throttled"]
+			p.cgroupThrottledTime = values["throttled_time"] / 1e9
+			p.cgroupCPUSupported = true
+		}
+	}
+	if p.cgroupMemoryDir != "" {
+		if limit, err := os.ReadFile(filepath.Join(p.cgroupMemoryDir, "memory.limit_in_bytes")); err == nil {
+			p.cgroupMemoryLimit = parseCgroupMemoryLimit(string(limit))
+			p.cgroupMemorySupported = true
+		}
+		if usage, err := os.ReadFile(filepath.Join(p.cgroupMemoryDir, "memory.usage_in_bytes")); err == nil {
+			p.cgroupMemoryUsage = parseFloat(string(usage))
+		}
+	}
+}
+
+// cgroupMount is a mounted cgroup hierarchy of the "/proc/self/mountinfo"
+type cgroupMount struct {
+	// root is the path of the cgroup which is shown as the mount point
+	root       string
+	mountPoint string
+	v2         bool
+	// the controllers of the cgroup v1 hierarchy, such as "cpu" and "memory"
+	controllers []string
+}
+
+// findCgroupDirs finding the cgroup directories of the CPU and memory controllers of current process,
+// the directory is empty when it cannot be resolved
+func findCgroupDirs() (cpuDir, memoryDir string, v2 bool) {
+	mountInfo, err := os.ReadFile(filepath.Join(procSelfDir, "mountinfo"))
+	if err != nil {
+		return "", "", false
+	}
+	cgroups, err := os.ReadFile(filepath.Join(procSelfDir, "cgroup"))
+	if err != nil {
+		return "", "", false
+	}
+	mounts, paths := parseCgroupMounts(string(mountInfo)), parseProcCgroup(string(cgroups))
+
+	cpuDir, memoryDir = resolveCgroupDir(mounts, paths, "cpu"), resolveCgroupDir(mounts, paths, "memory")
+	if cpuDir == "" && memoryDir == "" {
+		// no cgroup v1 controller mounted, the unified hierarchy of cgroup v2 has all the controllers
+		dir := resolveCgroupDir(mounts, paths, "")
+		cpuDir, memoryDir, v2 = dir, dir, true
+	}
+	return existingDir(cpuDir), existingDir(memoryDir), v2
+}
+
+// resolveCgroupDir resolving the directory of the cgroup controller, the empty controller means the cgroup v2
+func resolveCgroupDir(mounts []cgroupMount, paths map[string]string, controller string) string {
+	path, exist := paths[controller]
+	if !exist {
+		return ""
+	}
+	for _, m := range mounts {
+		if m.v2 != (controller == "") || (!m.v2 && !containsString(m.controllers, controller)) {
+			continue
+		}
+		// the mount point shows the cgroup of the root path, such as the cgroup of the container
+		// when the cgroup namespace is not enabled, so the path should be relative to it
+		if m.root == "/" {
+			return filepath.Join(m.mountPoint, path)
+		}
+		if path == m.root || strings.HasPrefix(path, m.root+"/") {
+			return filepath.Join(m.mountPoint, strings.TrimPrefix(path, m.root))
+		}
+	}
+	return ""
+}
+
+// parseCgroupMounts parsing the cgroup mounts from the "/proc/self/mountinfo", the line format is:
+// "<id> <parent> <major:minor> <root> <mount point> <options> [optional fields] - <fs type> <source> <super options>"
+func parseCgroupMounts(mountInfo string) []cgroupMount {
+	result := make([]cgroupMount, 0)
+	for _, line := range strings.Split(mountInfo, "\n") {
+		pre, post, found := strings.Cut(line, " - ")
+		if !found {
+			continue
+		}
+		fields, fsFields := strings.Fields(pre), strings.Fields(post)
+		if len(fields) < 5 || len(fsFields) < 1 {
+			continue
+		}
+		switch fsFields[0] {
+		case "cgroup2":
+			result = append(result, cgroupMount{root: fields[3], mountPoint: fields[4], v2: true})
+		case "cgroup":
+			if len(fsFields) < 3 {
+				continue
+			}
+			result = append(result, cgroupMount{root: fields[3], mountPoint: fields[4],
+				controllers: strings.Split(fsFields[2], ",")})
+		}
+	}
+	return result
+}
+
+// parseProcCgroup parsing the cgroup path of each controller from the "/proc/self/cgroup",
+// the line format is "<hierarchy id>:<controllers>:<path>", the cgroup v2 is declared as "0::<path>"
+func parseProcCgroup(content string) map[string]string {
+	result := make(map[string]string)
+	for _, line := range strings.Split(content, "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		if parts[0] == "0" && parts[1] == "" {
+			result[""] = parts[2]
+			continue
+		}
+		for _, controller := range strings.Split(parts[1], ",") {
+			result[controller] = parts[2]
+		}
+	}
+	return result
+}
+
+func existingDir(dir string) string {
+	if dir == "" {
+		return ""
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return ""
+	}
+	return dir
+}
+
+func containsString(values []string, val string) bool {
+	for _, v := range values {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
+
+// parseProcStatCPU parsing the user and system CPU seconds from the "/proc/self/stat"
+func parseProcStatCPU(stat string) (user, system float64) {
+	// the process name may contain spaces, so the fields are started after the last ")"
+	inx := strings.LastIndex(stat, ")")
+	if inx < 0 {
+		return 0, 0
+	}
+	// the first field after the name is "state"(3rd), the "utime" and "stime" are 14th and 15th
+	fields := strings.Fields(stat[inx+1:])
+	if len(fields) < 13 {
+		return 0, 0
+	}
+	return parseFloat(fields[11]) / procClockTicks, parseFloat(fields[12]) / procClockTicks
+}
+
+// parseProcStatus parsing the "Key: value [unit]" lines of the "/proc/self/status"
+func parseProcStatus(status string) map[string]float64 {
+	result := make(map[string]float64)
+	scanner := bufio.NewScanner(strings.NewReader(status))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), ":")
+		if !found {
+			continue
+		}
+		fields := strings.Fields(value)
+		if len(fields) == 0 {
+			continue
+		}
+		if v, err := strconv.ParseFloat(fields[0], 64); err == nil {
+			result[key] = v
+		}
+	}
+	return result
+}
+
+// parseProcMaxOpenFiles parsing the soft limit of the open files from the "/proc/self/limits"
+func parseProcMaxOpenFiles(limits string) float64 {
+	for _, line := range strings.Split(limits, "\n") {
+		if !strings.HasPrefix(line, "Max open files") {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, "Max open files"))
+		if len(fields) > 0 {
+			return parseFloat(fields[0])
+		}
+	}
+	return 0
+}
+
+// parseKeyValues parsing the "key value" lines, such as the "cpu.stat" of the cgroup
+func parseKeyValues(content string) map[string]float64 {
+	result := make(map[string]float64)
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if v, err := strconv.ParseFloat(fields[1], 64); err == nil {
+			result[fields[0]] = v
+		}
+	}
+	return result
+}
+
+// parseCgroupMemoryLimit parsing the memory limit of the cgroup, return zero when unlimited
+func parseCgroupMemoryLimit(limit string) float64 {
+	limit = strings.TrimSpace(limit)
+	if limit == "max" {
+		return 0
+	}
+	v := parseFloat(limit)
+	// the cgroup v1 uses a huge page aligned max int64 value as unlimited
+	if v >= float64(1<<62) {
+		return 0
+	}
+	return v
+}
+
+func parseFloat(val string) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
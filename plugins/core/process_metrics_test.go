@@ -0,0 +1,105 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProcStatCPU(t *testing.T) {
+	stat := "1234 (my (weird) app) S 1 1234 1234 0 -1 4194560 2000 0 0 0 250 120 0 0 20 0 12 0 100 0 0"
+	user, system := parseProcStatCPU(stat)
+	assert.Equal(t, 2.5, user, "user cpu seconds should be equal")
+	assert.Equal(t, 1.2, system, "system cpu seconds should be equal")
+
+	user, system = parseProcStatCPU("invalid")
+	assert.Equal(t, float64(0), user)
+	assert.Equal(t, float64(0), system)
+}
+
+func TestParseProcStatus(t *testing.T) {
+	status := "Name:\tapp\nVmSize:\t  123456 kB\nVmRSS:\t    2048 kB\nThreads:\t12\n" +
+		"voluntary_ctxt_switches:\t100\nnonvoluntary_ctxt_switches:\t5\n"
+	values := parseProcStatus(status)
+	assert.Equal(t, float64(123456), values["VmSize"])
+	assert.Equal(t, float64(2048), values["VmRSS"])
+	assert.Equal(t, float64(12), values["Threads"])
+	assert.Equal(t, float64(100), values["voluntary_ctxt_switches"])
+	assert.Equal(t, float64(5), values["nonvoluntary_ctxt_switches"])
+	_, exist := values["Name"]
+	assert.False(t, exist, "non numeric value should be ignored")
+}
+
+func TestParseProcMaxOpenFiles(t *testing.T) {
+	limits := "Limit                     Soft Limit           Hard Limit           Units     \n" +
+		"Max processes             62987                62987                processes \n" +
+		"Max open files            1024                 1048576              files     \n"
+	assert.Equal(t, float64(1024), parseProcMaxOpenFiles(limits))
+	assert.Equal(t, float64(0), parseProcMaxOpenFiles(""))
+}
+
+func TestParseCgroup(t *testing.T) {
+	values := parseKeyValues("usage_usec 1000\nnr_periods 20\nnr_throttled 3\nthrottled_usec 1500000\n")
+	assert.Equal(t, float64(20), values["nr_periods"])
+	assert.Equal(t, float64(3), values["nr_throttled"])
+	assert.Equal(t, float64(1500000), values["throttled_usec"])
+
+	assert.Equal(t, float64(0), parseCgroupMemoryLimit("max\n"))
+	assert.Equal(t, float64(0), parseCgroupMemoryLimit("9223372036854771712\n"))
+	assert.Equal(t, float64(536870912), parseCgroupMemoryLimit("536870912\n"))
+}
+
+func TestResolveCgroupV1Dir(t *testing.T) {
+	mountInfo := "25 30 0:22 / /sys/fs/cgroup ro,nosuid - tmpfs tmpfs ro,mode=755\n" +
+		"33 25 0:29 / /sys/fs/cgroup/cpu,cpuacct rw,nosuid shared:15 - cgroup cgroup rw,cpu,cpuacct\n" +
+		"34 25 0:30 / /sys/fs/cgroup/memory rw,nosuid shared:16 - cgroup cgroup rw,memory\n"
+	cgroups := "12:memory:/user.slice/app.service\n4:cpu,cpuacct:/user.slice\n1:name=systemd:/user.slice\n"
+	mounts, paths := parseCgroupMounts(mountInfo), parseProcCgroup(cgroups)
+
+	// the host has no cgroup namespace, the path of current process should be used
+	assert.Equal(t, "/sys/fs/cgroup/cpu,cpuacct/user.slice", resolveCgroupDir(mounts, paths, "cpu"))
+	assert.Equal(t, "/sys/fs/cgroup/memory/user.slice/app.service", resolveCgroupDir(mounts, paths, "memory"))
+	assert.Equal(t, "", resolveCgroupDir(mounts, paths, ""), "no cgroup v2 hierarchy")
+
+	// the container without the cgroup namespace, the cgroup of the container is mounted
+	mountInfo = "40 35 0:30 /docker/abc /sys/fs/cgroup/memory ro,nosuid - cgroup cgroup rw,memory\n"
+	mounts = parseCgroupMounts(mountInfo)
+	paths = parseProcCgroup("12:memory:/docker/abc\n")
+	assert.Equal(t, "/sys/fs/cgroup/memory", resolveCgroupDir(mounts, paths, "memory"))
+	paths = parseProcCgroup("12:memory:/docker/abc/sub\n")
+	assert.Equal(t, "/sys/fs/cgroup/memory/sub", resolveCgroupDir(mounts, paths, "memory"))
+	paths = parseProcCgroup("12:memory:/docker/other\n")
+	assert.Equal(t, "", resolveCgroupDir(mounts, paths, "memory"), "the cgroup is not visible")
+}
+
+func TestResolveCgroupV2Dir(t *testing.T) {
+	mountInfo := "35 24 0:30 / /sys/fs/cgroup rw,nosuid,nodev,noexec,relatime shared:9 - cgroup2 cgroup2 rw,nsdelegate\n"
+	mounts := parseCgroupMounts(mountInfo)
+
+	paths := parseProcCgroup("0::/user.slice/user-1000.slice/session-1.scope\n")
+	assert.Equal(t, "/sys/fs/cgroup/user.slice/user-1000.slice/session-1.scope", resolveCgroupDir(mounts, paths, ""))
+	assert.Equal(t, "", resolveCgroupDir(mounts, paths, "memory"))
+
+	// the cgroup namespace is enabled in the container
+	paths = parseProcCgroup("0::/\n")
+	assert.Equal(t, "/sys/fs/cgroup", resolveCgroupDir(mounts, paths, ""))
+
+	assert.Equal(t, "", resolveCgroupDir(nil, paths, ""), "no cgroup mounted")
+}
@@ -18,6 +18,7 @@
 package reporter
 
 import (
+	"sort"
 	"time"
 
 	commonv3 "github.com/apache/skywalking-go/protocols/collect/common/v3"
@@ -184,5 +185,9 @@ func (r *Transform) transformLabels(labels map[string]string) []*agentv3.Label {
 			Value: v,
 		})
 	}
+	// keep the labels in a stable order for the same meter
+	sort.Slice(ls, func(i, j int) bool {
+		return ls[i].Name < ls[j].Name
+	})
 	return ls
 }
@@ -82,6 +82,12 @@ func NewDefaultSpan(tracer *Tracer, parent TracingSpan) *DefaultSpan {
 	}
 }
 
+// SetStartTime is only applied by the span options while the span is created,
+// the start time is write-once and read without the lock.
+func (ds *DefaultSpan) SetStartTime(millis int64) {
+	ds.StartTime = time.UnixMilli(millis)
+}
+
 // For TracingSpan
 func (ds *DefaultSpan) SetOperationName(name string) {
 	ds.opLock.Lock()
@@ -75,6 +75,19 @@ func GetReportedSpans() []reporter.ReportedSpan {
 	return append([]reporter.ReportedSpan(nil), sr.Spans...)
 }
 
+func GetReportedLogs() []*logv3.LogData {
+	sr := Tracing.Reporter.(*StoreReporter)
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+	return append([]*logv3.LogData(nil), sr.Logs...)
+}
+
+// SetLogReporterConfig set the label keys and the frameworks which report the logs with the JSON body
+func SetLogReporterConfig(labelKeys, jsonBodyFrameworks []string) {
+	Tracing.logLabelKeys = labelKeys
+	Tracing.logJSONBodyFrameworks = jsonBodyFrameworks
+}
+
 // StoreReporter is the in-memory test reporter. SendTracing is invoked from
 // the per-segment collector goroutines while tests read the results, so the
 // storage must be synchronized (this used to be the test-harness data race
@@ -53,6 +53,13 @@ type Tracer struct {
 	meterMap                  *sync.Map
 	meterCollectListeners     []func()
 	meterCollectListenersLock sync.RWMutex
+	meterViews                []*MeterView
+	meterViewConflicts        map[string]bool
+	logErrorStack             bool
+	logLabelKeys              []string
+	logJSONBodyFrameworks     []string
+	logLimiter                *LogReportLimiter
+	logContextLayout          *LogContextLayout
 	ignoreSuffix              []string
 	traceIgnorePath           []string
 	mu                        sync.Mutex
@@ -54,10 +54,12 @@ const (
 	TagHTTPMethod      = "http.method"
 	TagHTTPParams      = "http.params"
 	TagHTTPHeaders     = "http.headers"
+	TagHTTPRouteParams = "http.route.params"
 	TagDBType          = "db.type"
 	TagDBInstance      = "db.instance"
 	TagDBStatement     = "db.statement"
 	TagDBSqlParameters = "db.sql.parameters"
+	TagDBBatchRows     = "db.batch.rows"
 	TagMQQueue         = "mq.queue"
 	TagMQBroker        = "mq.broker"
 	TagMQTopic         = "mq.topic"
@@ -91,6 +93,16 @@ func WithTag(key Tag, value string) SpanOption {
 	})
 }
 
+// WithStartTime set the start time(in milliseconds) of the Span,
+// such as creating the Span after the operation has been finished
+func WithStartTime(millis int64) SpanOption {
+	return buildSpanOption(func(s AdaptSpan) {
+		if span, ok := s.(interface{ SetStartTime(int64) }); ok {
+			span.SetStartTime(millis)
+		}
+	})
+}
+
 type spanOpImpl struct {
 	exe func(s AdaptSpan)
 }
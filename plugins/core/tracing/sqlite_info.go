@@ -0,0 +1,80 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tracing
+
+const sqliteMemoryPath = ":memory:"
+
+// SQLiteInfo is the database info of the SQLite drivers, the path of the database file is used as the peer.
+type SQLiteInfo struct {
+	Path      string
+	Component int32
+}
+
+func (i *SQLiteInfo) Peer() string {
+	return i.Path
+}
+
+func (i *SQLiteInfo) ComponentID() int32 {
+	return i.Component
+}
+
+func (i *SQLiteInfo) DBType() string {
+	return "SQLite"
+}
+
+func (i *SQLiteInfo) Database() string {
+	for index := len(i.Path) - 1; index >= 0; index-- {
+		if i.Path[index] == '/' || i.Path[index] == '\\' {
+			return i.Path[index+1:]
+		}
+	}
+	return i.Path
+}
+
+// ParseSQLiteDSN parses the file name or the URI filename of the SQLite data source name,
+// the component ID is provided by the driver plugin.
+func ParseSQLiteDSN(dsn string, componentID int32) *SQLiteInfo {
+	path := dsn
+	if index := indexByte(path, '?'); index >= 0 {
+		path = path[:index]
+	}
+	if hasPrefix(path, "file:") {
+		path = path[len("file:"):]
+		// the authority of the URI, only empty and "localhost" are valid
+		if hasPrefix(path, "//") {
+			path = path[2:]
+			if index := indexByte(path, '/'); index >= 0 {
+				path = path[index:]
+			}
+		}
+	}
+	// the empty path is a private temporary database
+	if path == "" {
+		path = sqliteMemoryPath
+	}
+	return &SQLiteInfo{Path: path, Component: componentID}
+}
+
+func indexByte(val string, target byte) int {
+	for i := 0; i < len(val); i++ {
+		if val[i] == target {
+			return i
+		}
+	}
+	return -1
+}
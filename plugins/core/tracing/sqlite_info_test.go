@@ -0,0 +1,49 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tracing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSQLiteDSN(t *testing.T) {
+	tests := []struct {
+		dsn      string
+		peer     string
+		database string
+	}{
+		{"test.db", "test.db", "test.db"},
+		{"./data/test.db?_busy_timeout=5000", "./data/test.db", "test.db"},
+		{`C:\data\test.db`, `C:\data\test.db`, "test.db"},
+		{":memory:", ":memory:", ":memory:"},
+		{"file::memory:?cache=shared", ":memory:", ":memory:"},
+		{"file:memdb?mode=memory&cache=shared", "memdb", "memdb"},
+		{"file:///var/lib/app.db?mode=ro", "/var/lib/app.db", "app.db"},
+		{"file://localhost/var/lib/app.db", "/var/lib/app.db", "app.db"},
+		{"", ":memory:", ":memory:"},
+	}
+	for _, test := range tests {
+		info := ParseSQLiteDSN(test.dsn, 5026)
+		assert.Equal(t, test.peer, info.Peer(), test.dsn)
+		assert.Equal(t, test.database, info.Database(), test.dsn)
+		assert.Equal(t, int32(5026), info.ComponentID())
+		assert.Equal(t, "SQLite", info.DBType())
+	}
+}
@@ -330,6 +330,9 @@ func TestSpanOperation(t *testing.T) {
 			}
 			return false
 		}},
+		{tracing.WithStartTime(1000), func(s *RootSegmentSpan) bool {
+			return s.StartTime() == 1000
+		}},
 	}
 
 	for createInx, spanCreate := range spanCreations {
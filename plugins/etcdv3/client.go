@@ -0,0 +1,50 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcdv3
+
+import (
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+// NewClientInterceptor records the endpoints of the client as the peer of the KV, Lease and Watcher,
+// the gRPC calls of creating the client and its background goroutines are not traced.
+type NewClientInterceptor struct {
+}
+
+func (n *NewClientInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	invocation.SetContext(&operationContext{grpcIgnored: ignoreGRPC()})
+	return nil
+}
+
+func (n *NewClientInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if ctx, ok := invocation.GetContext().(*operationContext); ok {
+		restoreGRPC(ctx.grpcIgnored)
+	}
+	client, ok := result[0].(*clientv3.Client)
+	if !ok || client == nil {
+		return nil
+	}
+	cfg := invocation.Args()[0].(clientv3.Config)
+	peer := buildPeer(cfg.Endpoints)
+	setInstancePeer(client.KV, peer)
+	setInstancePeer(client.Lease, peer)
+	setInstancePeer(client.Watcher, peer)
+	return nil
+}
@@ -0,0 +1,155 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcdv3
+
+import (
+	"strings"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	// the etcd client calls the server through gRPC, reuse the gRPC component
+	etcdComponentID int32 = 23
+	etcdPrefix            = "Etcd/"
+	etcdDBType            = "Etcd"
+
+	tagEtcdKey      = "etcd.key"
+	tagEtcdLeaseID  = "etcd.lease.id"
+	tagEtcdRevision = "etcd.revision"
+	tagEtcdEvents   = "etcd.events"
+)
+
+// operationContext is the invocation context of a traced etcd operation.
+type operationContext struct {
+	span tracing.Span
+	// grpcIgnored is the previous value of the gRPC ignore flag, restored after the operation
+	grpcIgnored interface{}
+}
+
+// buildPeer joins the endpoints of the client without the URL scheme.
+func buildPeer(endpoints []string) string {
+	peers := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		if index := strings.Index(endpoint, "://"); index >= 0 {
+			endpoint = endpoint[index+3:]
+		}
+		if endpoint != "" {
+			peers = append(peers, endpoint)
+		}
+	}
+	return strings.Join(peers, ",")
+}
+
+func instancePeer(instance interface{}) string {
+	enhanced, ok := instance.(operator.EnhancedInstance)
+	if !ok {
+		return ""
+	}
+	peer, _ := enhanced.GetSkyWalkingDynamicField().(string)
+	return peer
+}
+
+func setInstancePeer(instance interface{}, peer string) {
+	if enhanced, ok := instance.(operator.EnhancedInstance); ok && peer != "" {
+		enhanced.SetSkyWalkingDynamicField(peer)
+	}
+}
+
+// ignoreGRPC asks the grpc plugin not to trace the calls in the current goroutine,
+// and the goroutines started from it, the previous value is returned for restoring.
+func ignoreGRPC() interface{} {
+	previous := tracing.GetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey)
+	tracing.SetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey, true)
+	return previous
+}
+
+func restoreGRPC(previous interface{}) {
+	tracing.SetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey, previous)
+}
+
+// beginOperation creates the exit span of the operation, the gRPC calls inside it are not traced again.
+// The operation is not traced when the peer of the client is unknown, such as the client not created by New.
+func beginOperation(invocation operator.Invocation, operation, peer string, opts ...tracing.SpanOption) tracing.Span {
+	if peer == "" {
+		return nil
+	}
+	opts = append([]tracing.SpanOption{
+		tracing.WithLayer(tracing.SpanLayerDatabase),
+		tracing.WithComponent(etcdComponentID),
+		tracing.WithTag(tracing.TagDBType, etcdDBType),
+	}, opts...)
+	span, err := tracing.CreateExitSpan(etcdPrefix+operation, peer, func(headerKey, headerValue string) error {
+		return nil
+	}, opts...)
+	if err != nil {
+		return nil
+	}
+	invocation.SetContext(&operationContext{span: span, grpcIgnored: ignoreGRPC()})
+	return span
+}
+
+func endOperation(invocation operator.Invocation, err interface{}) {
+	ctx, ok := invocation.GetContext().(*operationContext)
+	if !ok {
+		return
+	}
+	restoreGRPC(ctx.grpcIgnored)
+	if e, ok := err.(error); ok && e != nil {
+		ctx.span.Error(e.Error())
+	}
+	ctx.span.End()
+}
+
+func tagKeys(span tracing.Span, keys []string) {
+	if span != nil && len(keys) > 0 {
+		span.Tag(tagEtcdKey, strings.Join(keys, ","))
+	}
+}
+
+// opKeys returns the keys accessed by the operation, including the operations of a transaction.
+func opKeys(op clientv3.Op) []string {
+	if !op.IsTxn() {
+		return []string{string(op.KeyBytes())}
+	}
+	cmps, thenOps, elseOps := op.Txn()
+	keys := cmpKeys(cmps)
+	for _, ops := range [][]clientv3.Op{thenOps, elseOps} {
+		keys = append(keys, opsKeys(ops)...)
+	}
+	return keys
+}
+
+func opsKeys(ops []clientv3.Op) []string {
+	keys := make([]string, 0, len(ops))
+	for _, op := range ops {
+		keys = append(keys, opKeys(op)...)
+	}
+	return keys
+}
+
+func cmpKeys(cmps []clientv3.Cmp) []string {
+	keys := make([]string, 0, len(cmps))
+	for i := range cmps {
+		keys = append(keys, string(cmps[i].KeyBytes()))
+	}
+	return keys
+}
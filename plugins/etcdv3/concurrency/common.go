@@ -0,0 +1,77 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrency
+
+import (
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	// the etcd client calls the server through gRPC, reuse the gRPC component
+	etcdComponentID int32 = 23
+	etcdPrefix            = "Etcd/"
+	etcdDBType            = "Etcd"
+
+	tagEtcdKey = "etcd.key"
+
+	// the expected results of the helpers, such as concurrency.ErrLocked, which are not errors of etcd
+	errLockedMessage           = "mutex: Locked by another session"
+	errElectionNoLeaderMessage = "election: no leader"
+)
+
+// savePrefix records the key prefix of the created election.
+func savePrefix(invocation operator.Invocation, result interface{}) {
+	if enhanced, ok := result.(operator.EnhancedInstance); ok {
+		enhanced.SetSkyWalkingDynamicField(invocation.Args()[1].(string))
+	}
+}
+
+// beginLocalSpan creates the local span of the helper, the KV operations inside it are traced as its children.
+func beginLocalSpan(invocation operator.Invocation, operation string) {
+	span, err := tracing.CreateLocalSpan(etcdPrefix+operation,
+		tracing.WithLayer(tracing.SpanLayerDatabase),
+		tracing.WithComponent(etcdComponentID),
+		tracing.WithTag(tracing.TagDBType, etcdDBType),
+	)
+	if err != nil {
+		return
+	}
+	if enhanced, ok := invocation.CallerInstance().(operator.EnhancedInstance); ok && config.CollectKey {
+		if prefix, ok := enhanced.GetSkyWalkingDynamicField().(string); ok {
+			span.Tag(tagEtcdKey, prefix)
+		}
+	}
+	invocation.SetContext(span)
+}
+
+func endLocalSpan(invocation operator.Invocation, err interface{}) {
+	span, ok := invocation.GetContext().(tracing.Span)
+	if !ok {
+		return
+	}
+	if e, ok := err.(error); ok && e != nil {
+		switch e.Error() {
+		case errLockedMessage, errElectionNoLeaderMessage:
+			span.Log("result", e.Error())
+		default:
+			span.Error(e.Error())
+		}
+	}
+	span.End()
+}
@@ -0,0 +1,88 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrency
+
+import (
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+// NewElectionInterceptor records the key prefix of the created or resumed election.
+type NewElectionInterceptor struct {
+}
+
+func (n *NewElectionInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *NewElectionInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	savePrefix(invocation, result[0])
+	return nil
+}
+
+type ElectionCampaignInterceptor struct {
+}
+
+func (e *ElectionCampaignInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLocalSpan(invocation, "Campaign")
+	return nil
+}
+
+func (e *ElectionCampaignInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endLocalSpan(invocation, result[0])
+	return nil
+}
+
+type ElectionProclaimInterceptor struct {
+}
+
+func (e *ElectionProclaimInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLocalSpan(invocation, "Proclaim")
+	return nil
+}
+
+func (e *ElectionProclaimInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endLocalSpan(invocation, result[0])
+	return nil
+}
+
+type ElectionResignInterceptor struct {
+}
+
+func (e *ElectionResignInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLocalSpan(invocation, "Resign")
+	return nil
+}
+
+func (e *ElectionResignInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endLocalSpan(invocation, result[0])
+	return nil
+}
+
+// ElectionLeaderInterceptor traces the query of the leader, no leader elected is not an error.
+type ElectionLeaderInterceptor struct {
+}
+
+func (e *ElectionLeaderInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLocalSpan(invocation, "Leader")
+	return nil
+}
+
+func (e *ElectionLeaderInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endLocalSpan(invocation, result[1])
+	return nil
+}
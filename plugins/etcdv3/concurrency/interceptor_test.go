@@ -0,0 +1,115 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrency
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type testEnhancedInstance struct {
+	field interface{}
+}
+
+func (t *testEnhancedInstance) GetSkyWalkingDynamicField() interface{} {
+	return t.field
+}
+
+func (t *testEnhancedInstance) SetSkyWalkingDynamicField(v interface{}) {
+	t.field = v
+}
+
+type testMutex struct {
+	key string
+}
+
+func (m *testMutex) Key() string {
+	return m.key
+}
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestMutexInterceptors(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectKey = true
+	defer func() {
+		config.CollectKey = false
+	}()
+
+	mutex := &testMutex{key: "/lock/job/694d7a4a1c0b3a04"}
+	lock := &MutexLockInterceptor{}
+	invocation := operator.NewInvocation(mutex, nil)
+	assert.Nil(t, lock.BeforeInvoke(invocation))
+	assert.Nil(t, lock.AfterInvoke(invocation, nil))
+
+	unlock := &MutexUnlockInterceptor{}
+	invocation = operator.NewInvocation(mutex, nil)
+	assert.Nil(t, unlock.BeforeInvoke(invocation))
+	mutex.key = "\x00"
+	assert.Nil(t, unlock.AfterInvoke(invocation, nil))
+
+	tryLock := &MutexTryLockInterceptor{}
+	invocation = operator.NewInvocation(mutex, nil)
+	assert.Nil(t, tryLock.BeforeInvoke(invocation))
+	assert.Nil(t, tryLock.AfterInvoke(invocation, errors.New(errLockedMessage)))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 3, len(spans), "spans length should be 3")
+	assert.Equal(t, "Etcd/Lock", spans[0].OperationName())
+	assert.Equal(t, etcdComponentID, spans[0].ComponentID())
+	assert.Equal(t, "/lock/job/694d7a4a1c0b3a04", spans[0].Tags()[1].Value)
+	assert.Equal(t, "Etcd/Unlock", spans[1].OperationName())
+	assert.Equal(t, "/lock/job/694d7a4a1c0b3a04", spans[1].Tags()[1].Value)
+	assert.Equal(t, "Etcd/TryLock", spans[2].OperationName())
+	assert.Equal(t, 1, len(spans[2].Tags()), "the key should not be tagged when the lock is not held")
+	assert.False(t, spans[2].IsError(), "locked by another session should not be an error")
+	assert.Equal(t, errLockedMessage, spans[2].Logs()[0].Data[0].Value)
+}
+
+func TestElectionInterceptors(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	election := &testEnhancedInstance{}
+	assert.Nil(t, (&NewElectionInterceptor{}).AfterInvoke(operator.NewInvocation(nil, nil, "/election/job"), election))
+
+	campaign := &ElectionCampaignInterceptor{}
+	invocation := operator.NewInvocation(election, nil, "node-1")
+	assert.Nil(t, campaign.BeforeInvoke(invocation))
+	assert.Nil(t, campaign.AfterInvoke(invocation, errors.New("context deadline exceeded")))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "Etcd/Campaign", spans[0].OperationName())
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+	for _, tag := range spans[0].Tags() {
+		assert.NotEqual(t, tagEtcdKey, tag.Key, "prefix should not be collected")
+	}
+	assert.Equal(t, "Etcd", spans[0].Tags()[0].Value)
+	assert.Equal(t, tracing.TagDBType, spans[0].Tags()[0].Key)
+}
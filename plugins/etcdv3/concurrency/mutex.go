@@ -0,0 +1,91 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package concurrency
+
+import (
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// lockKeyHolder is implemented by the Mutex, the key is only available while the lock is held.
+type lockKeyHolder interface {
+	Key() string
+}
+
+// tagLockKey tags the key that the session writes for holding the lock.
+func tagLockKey(invocation operator.Invocation) {
+	span, ok := invocation.GetContext().(tracing.Span)
+	if !ok || !config.CollectKey {
+		return
+	}
+	holder, ok := invocation.CallerInstance().(lockKeyHolder)
+	if !ok {
+		return
+	}
+	// the mutex resets the key to "\x00" when the lock is released
+	if key := holder.Key(); key != "" && key != "\x00" {
+		span.Tag(tagEtcdKey, key)
+	}
+}
+
+type MutexLockInterceptor struct {
+}
+
+func (m *MutexLockInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLocalSpan(invocation, "Lock")
+	return nil
+}
+
+func (m *MutexLockInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if result[0] == nil {
+		tagLockKey(invocation)
+	}
+	endLocalSpan(invocation, result[0])
+	return nil
+}
+
+// MutexTryLockInterceptor traces the TryLock, the lock held by another session is not an error.
+type MutexTryLockInterceptor struct {
+}
+
+func (m *MutexTryLockInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLocalSpan(invocation, "TryLock")
+	return nil
+}
+
+func (m *MutexTryLockInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if result[0] == nil {
+		tagLockKey(invocation)
+	}
+	endLocalSpan(invocation, result[0])
+	return nil
+}
+
+type MutexUnlockInterceptor struct {
+}
+
+func (m *MutexUnlockInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLocalSpan(invocation, "Unlock")
+	tagLockKey(invocation)
+	return nil
+}
+
+func (m *MutexUnlockInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endLocalSpan(invocation, result[0])
+	return nil
+}
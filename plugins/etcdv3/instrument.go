@@ -0,0 +1,283 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcdv3
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "etcdv3"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "go.etcd.io/etcd/client/v3"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return strings.HasPrefix(version, "v3.")
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewStaticMethodEnhance("New",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "Config"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "*Client"), instrument.WithResultType(1, "error")),
+			Interceptor: "NewClientInterceptor",
+		},
+		// KV operations
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At:          instrument.NewStructEnhance("kv"),
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At:          instrument.NewStructEnhance("txn"),
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*kv", "Do",
+				instrument.WithArgsCount(2), instrument.WithArgType(1, "Op"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "OpResponse")),
+			Interceptor: "KVDoInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*kv", "Txn",
+				instrument.WithArgsCount(1),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "Txn")),
+			Interceptor: "KVTxnInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*txn", "If",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "[]Cmp")),
+			Interceptor: "TxnIfInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*txn", "Then",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "[]Op")),
+			Interceptor: "TxnOpsInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*txn", "Else",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "[]Op")),
+			Interceptor: "TxnOpsInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*txn", "Commit",
+				instrument.WithArgsCount(0),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "*TxnResponse")),
+			Interceptor: "TxnCommitInterceptor",
+		},
+		// Lease operations
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At:          instrument.NewStructEnhance("lessor"),
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*lessor", "Grant",
+				instrument.WithArgsCount(2), instrument.WithArgType(1, "int64"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "*LeaseGrantResponse")),
+			Interceptor: "LeaseGrantInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*lessor", "Revoke",
+				instrument.WithArgsCount(2), instrument.WithArgType(1, "LeaseID"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "*LeaseRevokeResponse")),
+			Interceptor: "LeaseRevokeInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*lessor", "TimeToLive",
+				instrument.WithArgType(1, "LeaseID"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "*LeaseTimeToLiveResponse")),
+			Interceptor: "LeaseTimeToLiveInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*lessor", "KeepAlive",
+				instrument.WithArgsCount(2), instrument.WithArgType(1, "LeaseID"),
+				instrument.WithResultCount(2)),
+			Interceptor: "LeaseKeepAliveInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*lessor", "KeepAliveOnce",
+				instrument.WithArgsCount(2), instrument.WithArgType(1, "LeaseID"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "*LeaseKeepAliveResponse")),
+			Interceptor: "LeaseKeepAliveOnceInterceptor",
+		},
+		// Watch operations, the gRPC stream has been renamed since v3.6
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At:          instrument.NewStructEnhance("watcher"),
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At:          instrument.NewStructEnhance("watchGrpcStream"),
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At:          instrument.NewStructEnhance("watchGRPCStream"),
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*watcher", "Watch",
+				instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "WatchChan")),
+			Interceptor: "WatchInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*watcher", "newWatcherGrpcStream",
+				instrument.WithArgsCount(1), instrument.WithResultCount(1)),
+			Interceptor: "WatchStreamInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*watcher", "newWatcherGRPCStream",
+				instrument.WithArgsCount(1), instrument.WithResultCount(1)),
+			Interceptor: "WatchStreamInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*watchGrpcStream", "unicastResponse",
+				instrument.WithArgsCount(2), instrument.WithArgType(0, "*WatchResponse"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "bool")),
+			Interceptor: "WatchResponseInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "clientv3",
+			At: instrument.NewMethodEnhance("*watchGRPCStream", "unicastResponse",
+				instrument.WithArgsCount(2), instrument.WithArgType(0, "*WatchResponse"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "bool")),
+			Interceptor: "WatchResponseInterceptor",
+		},
+		// lock and election helpers
+		{
+			PackagePath: "concurrency",
+			At:          instrument.NewStructEnhance("Election"),
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewMethodEnhance("*Mutex", "Lock",
+				instrument.WithArgsCount(1), instrument.WithResultCount(1)),
+			Interceptor: "MutexLockInterceptor",
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewMethodEnhance("*Mutex", "TryLock",
+				instrument.WithArgsCount(1), instrument.WithResultCount(1)),
+			Interceptor: "MutexTryLockInterceptor",
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewMethodEnhance("*Mutex", "Unlock",
+				instrument.WithArgsCount(1), instrument.WithResultCount(1)),
+			Interceptor: "MutexUnlockInterceptor",
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewStaticMethodEnhance("NewElection",
+				instrument.WithArgsCount(2), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "*Election")),
+			Interceptor: "NewElectionInterceptor",
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewStaticMethodEnhance("ResumeElection",
+				instrument.WithArgsCount(4), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "*Election")),
+			Interceptor: "NewElectionInterceptor",
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewMethodEnhance("*Election", "Campaign",
+				instrument.WithArgsCount(2), instrument.WithResultCount(1)),
+			Interceptor: "ElectionCampaignInterceptor",
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewMethodEnhance("*Election", "Proclaim",
+				instrument.WithArgsCount(2), instrument.WithResultCount(1)),
+			Interceptor: "ElectionProclaimInterceptor",
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewMethodEnhance("*Election", "Resign",
+				instrument.WithArgsCount(1), instrument.WithResultCount(1)),
+			Interceptor: "ElectionResignInterceptor",
+		},
+		{
+			PackagePath: "concurrency",
+			At: instrument.NewMethodEnhance("*Election", "Leader",
+				instrument.WithArgsCount(1), instrument.WithResultCount(2)),
+			Interceptor: "ElectionLeaderInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
@@ -0,0 +1,238 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcdv3
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	agentv3 "github.com/apache/skywalking-go/protocols/collect/language/agent/v3"
+)
+
+type testEnhancedInstance struct {
+	field interface{}
+}
+
+func (t *testEnhancedInstance) GetSkyWalkingDynamicField() interface{} {
+	return t.field
+}
+
+func (t *testEnhancedInstance) SetSkyWalkingDynamicField(v interface{}) {
+	t.field = v
+}
+
+type testKV struct {
+	clientv3.KV
+	testEnhancedInstance
+}
+
+type testLease struct {
+	clientv3.Lease
+	testEnhancedInstance
+}
+
+type testWatcher struct {
+	clientv3.Watcher
+	testEnhancedInstance
+}
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestBuildPeer(t *testing.T) {
+	assert.Equal(t, "etcd-1:2379,etcd-2:2379", buildPeer([]string{"http://etcd-1:2379", "https://etcd-2:2379"}))
+	assert.Equal(t, "127.0.0.1:2379", buildPeer([]string{"127.0.0.1:2379", ""}))
+}
+
+func TestNewClientInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	interceptor := &NewClientInterceptor{}
+	invocation := operator.NewInvocation(nil, clientv3.Config{Endpoints: []string{"http://etcd-1:2379", "etcd-2:2379"}})
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Equal(t, true, tracing.GetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey),
+		"gRPC calls should be ignored when creating the client")
+
+	client := &clientv3.Client{KV: &testKV{}, Lease: &testLease{}, Watcher: &testWatcher{}}
+	assert.Nil(t, interceptor.AfterInvoke(invocation, client, nil))
+	assert.Nil(t, tracing.GetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey), "ignore flag should be restored")
+	assert.Equal(t, "etcd-1:2379,etcd-2:2379", client.KV.(*testKV).field)
+	assert.Equal(t, "etcd-1:2379,etcd-2:2379", client.Lease.(*testLease).field)
+	assert.Equal(t, "etcd-1:2379,etcd-2:2379", client.Watcher.(*testWatcher).field)
+}
+
+func TestKVDoInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectKey = true
+	defer func() {
+		config.CollectKey = false
+	}()
+
+	interceptor := &KVDoInterceptor{}
+	invocation := operator.NewInvocation(&testEnhancedInstance{field: "etcd:2379"}, nil, clientv3.OpPut("/config/foo", "bar"))
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Equal(t, true, tracing.GetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey),
+		"gRPC calls should be ignored in the operation")
+	assert.Nil(t, interceptor.AfterInvoke(invocation, clientv3.OpResponse{}, errors.New("etcdserver: request timed out")))
+	assert.Nil(t, tracing.GetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey), "ignore flag should be restored")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "Etcd/Put", spans[0].OperationName())
+	assert.Equal(t, "etcd:2379", spans[0].Peer())
+	assert.Equal(t, etcdComponentID, spans[0].ComponentID())
+	assert.Equal(t, "Etcd", tagValue(spans[0], tracing.TagDBType))
+	assert.Equal(t, "/config/foo", tagValue(spans[0], tagEtcdKey))
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+}
+
+func TestKVDoInterceptorWithoutKey(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	interceptor := &KVDoInterceptor{}
+	invocation := operator.NewInvocation(&testEnhancedInstance{field: "etcd:2379"}, nil,
+		clientv3.OpGet("/config/", clientv3.WithPrefix()))
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, interceptor.AfterInvoke(invocation, clientv3.OpResponse{}, nil))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "Etcd/Get", spans[0].OperationName())
+	assert.Equal(t, "", tagValue(spans[0], tagEtcdKey), "key should not be collected")
+	assert.False(t, spans[0].IsError(), "span should not be marked as error")
+}
+
+func TestKVDoInterceptorWithoutPeer(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	interceptor := &KVDoInterceptor{}
+	invocation := operator.NewInvocation(&testEnhancedInstance{}, nil, clientv3.OpDelete("/config/foo"))
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, invocation.GetContext(), "span should not be created without peer")
+	assert.Nil(t, tracing.GetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey),
+		"gRPC calls should be traced without the etcd span")
+	assert.Nil(t, interceptor.AfterInvoke(invocation, clientv3.OpResponse{}, nil))
+}
+
+func TestTxnInterceptors(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectKey = true
+	defer func() {
+		config.CollectKey = false
+	}()
+
+	kv := &testEnhancedInstance{field: "etcd:2379"}
+	txn := &testEnhancedInstance{}
+	assert.Nil(t, (&KVTxnInterceptor{}).AfterInvoke(operator.NewInvocation(kv, nil), txn))
+
+	cmps := []clientv3.Cmp{clientv3.Compare(clientv3.Version("/lock"), "=", 0)}
+	assert.Nil(t, (&TxnIfInterceptor{}).BeforeInvoke(operator.NewInvocation(txn, cmps)))
+	ops := []clientv3.Op{clientv3.OpPut("/lock", "owner"), clientv3.OpGet("/owner")}
+	assert.Nil(t, (&TxnOpsInterceptor{}).BeforeInvoke(operator.NewInvocation(txn, ops)))
+
+	interceptor := &TxnCommitInterceptor{}
+	invocation := operator.NewInvocation(txn)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, interceptor.AfterInvoke(invocation, &clientv3.TxnResponse{}, nil))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "Etcd/Txn", spans[0].OperationName())
+	assert.Equal(t, "etcd:2379", spans[0].Peer())
+	assert.Equal(t, "/lock,/lock,/owner", tagValue(spans[0], tagEtcdKey))
+}
+
+func TestLeaseGrantInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	interceptor := &LeaseGrantInterceptor{}
+	invocation := operator.NewInvocation(&testEnhancedInstance{field: "etcd:2379"}, nil, int64(10))
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	resp := &clientv3.LeaseGrantResponse{ID: clientv3.LeaseID(0x694d7b1b5c9a2f04), TTL: 10}
+	assert.Nil(t, interceptor.AfterInvoke(invocation, resp, nil))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "Etcd/LeaseGrant", spans[0].OperationName())
+	assert.Equal(t, "694d7b1b5c9a2f04", tagValue(spans[0], tagEtcdLeaseID))
+}
+
+func TestWatchResponseInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectKey = true
+	defer func() {
+		config.CollectKey = false
+	}()
+
+	stream := &testEnhancedInstance{}
+	assert.Nil(t, (&WatchStreamInterceptor{}).AfterInvoke(
+		operator.NewInvocation(&testEnhancedInstance{field: "etcd:2379"}, nil), stream))
+	assert.Equal(t, "etcd:2379", stream.field)
+
+	interceptor := &WatchResponseInterceptor{}
+	created := operator.NewInvocation(stream, &clientv3.WatchResponse{Created: true}, int64(0))
+	assert.Nil(t, interceptor.BeforeInvoke(created))
+	assert.Nil(t, created.GetContext(), "span should not be created for the created response")
+
+	resp := &clientv3.WatchResponse{
+		Header: etcdserverpb.ResponseHeader{Revision: 12},
+		Events: []*clientv3.Event{
+			{Type: mvccpb.PUT, Kv: &mvccpb.KeyValue{Key: []byte("/config/foo")}},
+			{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte("/config/bar")}},
+		},
+	}
+	invocation := operator.NewInvocation(stream, resp, int64(0))
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, interceptor.AfterInvoke(invocation, true))
+	assert.Equal(t, true, tracing.GetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey),
+		"gRPC calls of the watch stream should still be ignored")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "Etcd/WatchResponse", spans[0].OperationName())
+	assert.Equal(t, agentv3.SpanType_Local, spans[0].SpanType())
+	assert.Equal(t, "12", tagValue(spans[0], tagEtcdRevision))
+	assert.Equal(t, "2", tagValue(spans[0], tagEtcdEvents))
+	assert.Equal(t, "/config/foo,/config/bar", tagValue(spans[0], tagEtcdKey))
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,140 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcdv3
+
+import (
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+// txnInfo is stored in the enhanced transaction, the keys are only collected when enabled.
+type txnInfo struct {
+	peer string
+	keys []string
+}
+
+// KVDoInterceptor traces the Get, Put, Delete and Txn operations, which all go through kv.Do.
+type KVDoInterceptor struct {
+}
+
+func (k *KVDoInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	op := invocation.Args()[1].(clientv3.Op)
+	operation := opOperation(op)
+	if operation == "" {
+		return nil
+	}
+	span := beginOperation(invocation, operation, instancePeer(invocation.CallerInstance()))
+	if config.CollectKey {
+		tagKeys(span, opKeys(op))
+	}
+	return nil
+}
+
+func (k *KVDoInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endOperation(invocation, result[1])
+	return nil
+}
+
+// KVTxnInterceptor passes the peer of the KV to the created transaction.
+type KVTxnInterceptor struct {
+}
+
+func (k *KVTxnInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (k *KVTxnInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if txn, ok := result[0].(operator.EnhancedInstance); ok {
+		txn.SetSkyWalkingDynamicField(&txnInfo{peer: instancePeer(invocation.CallerInstance())})
+	}
+	return nil
+}
+
+// TxnIfInterceptor collects the keys of the comparisons in the transaction.
+type TxnIfInterceptor struct {
+}
+
+func (t *TxnIfInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	if info := instanceTxnInfo(invocation.CallerInstance()); info != nil && config.CollectKey {
+		cmps, _ := invocation.Args()[0].([]clientv3.Cmp)
+		info.keys = append(info.keys, cmpKeys(cmps)...)
+	}
+	return nil
+}
+
+func (t *TxnIfInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+// TxnOpsInterceptor collects the keys of the Then and Else operations in the transaction.
+type TxnOpsInterceptor struct {
+}
+
+func (t *TxnOpsInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	if info := instanceTxnInfo(invocation.CallerInstance()); info != nil && config.CollectKey {
+		ops, _ := invocation.Args()[0].([]clientv3.Op)
+		info.keys = append(info.keys, opsKeys(ops)...)
+	}
+	return nil
+}
+
+func (t *TxnOpsInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+// TxnCommitInterceptor traces the commit of the transaction.
+type TxnCommitInterceptor struct {
+}
+
+func (t *TxnCommitInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	info := instanceTxnInfo(invocation.CallerInstance())
+	if info == nil {
+		return nil
+	}
+	span := beginOperation(invocation, "Txn", info.peer)
+	tagKeys(span, info.keys)
+	return nil
+}
+
+func (t *TxnCommitInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endOperation(invocation, result[1])
+	return nil
+}
+
+func opOperation(op clientv3.Op) string {
+	switch {
+	case op.IsGet():
+		return "Get"
+	case op.IsPut():
+		return "Put"
+	case op.IsDelete():
+		return "Delete"
+	case op.IsTxn():
+		return "Txn"
+	}
+	return ""
+}
+
+func instanceTxnInfo(instance interface{}) *txnInfo {
+	if enhanced, ok := instance.(operator.EnhancedInstance); ok {
+		info, _ := enhanced.GetSkyWalkingDynamicField().(*txnInfo)
+		return info
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcdv3
+
+import (
+	"fmt"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// LeaseGrantInterceptor traces the lease grant, the granted lease id is tagged.
+type LeaseGrantInterceptor struct {
+}
+
+func (l *LeaseGrantInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginOperation(invocation, "LeaseGrant", instancePeer(invocation.CallerInstance()))
+	return nil
+}
+
+func (l *LeaseGrantInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if ctx, ok := invocation.GetContext().(*operationContext); ok {
+		if resp, ok := result[0].(*clientv3.LeaseGrantResponse); ok && resp != nil {
+			ctx.span.Tag(tagEtcdLeaseID, formatLeaseID(resp.ID))
+		}
+	}
+	endOperation(invocation, result[1])
+	return nil
+}
+
+// LeaseRevokeInterceptor traces the lease revoke.
+type LeaseRevokeInterceptor struct {
+}
+
+func (l *LeaseRevokeInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLeaseOperation(invocation, "LeaseRevoke")
+	return nil
+}
+
+func (l *LeaseRevokeInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endOperation(invocation, result[1])
+	return nil
+}
+
+// LeaseTimeToLiveInterceptor traces the query of the lease TTL.
+type LeaseTimeToLiveInterceptor struct {
+}
+
+func (l *LeaseTimeToLiveInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLeaseOperation(invocation, "LeaseTimeToLive")
+	return nil
+}
+
+func (l *LeaseTimeToLiveInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endOperation(invocation, result[1])
+	return nil
+}
+
+// LeaseKeepAliveInterceptor traces the registration of keeping the lease alive,
+// the keepalive requests sent in the background afterward are not traced.
+type LeaseKeepAliveInterceptor struct {
+}
+
+func (l *LeaseKeepAliveInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLeaseOperation(invocation, "LeaseKeepAlive")
+	return nil
+}
+
+func (l *LeaseKeepAliveInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endOperation(invocation, result[1])
+	return nil
+}
+
+// LeaseKeepAliveOnceInterceptor traces the single keepalive of the lease.
+type LeaseKeepAliveOnceInterceptor struct {
+}
+
+func (l *LeaseKeepAliveOnceInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	beginLeaseOperation(invocation, "LeaseKeepAliveOnce")
+	return nil
+}
+
+func (l *LeaseKeepAliveOnceInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endOperation(invocation, result[1])
+	return nil
+}
+
+func beginLeaseOperation(invocation operator.Invocation, operation string) {
+	id := invocation.Args()[1].(clientv3.LeaseID)
+	beginOperation(invocation, operation, instancePeer(invocation.CallerInstance()),
+		tracing.WithTag(tagEtcdLeaseID, formatLeaseID(id)))
+}
+
+// formatLeaseID formats the lease id as hexadecimal, same as etcdctl.
+func formatLeaseID(id clientv3.LeaseID) string {
+	return fmt.Sprintf("%x", int64(id))
+}
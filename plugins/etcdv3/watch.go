@@ -0,0 +1,111 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package etcdv3
+
+import (
+	"fmt"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// WatchInterceptor traces the registration of the watch.
+type WatchInterceptor struct {
+}
+
+func (w *WatchInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	span := beginOperation(invocation, "Watch", instancePeer(invocation.CallerInstance()))
+	if config.CollectKey {
+		tagKeys(span, []string{invocation.Args()[1].(string)})
+	}
+	return nil
+}
+
+func (w *WatchInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	endOperation(invocation, nil)
+	return nil
+}
+
+// WatchStreamInterceptor passes the peer of the watcher to the created gRPC watch stream.
+type WatchStreamInterceptor struct {
+}
+
+func (w *WatchStreamInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (w *WatchStreamInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	setInstancePeer(result[0], instancePeer(invocation.CallerInstance()))
+	return nil
+}
+
+// WatchResponseInterceptor creates a local span for each watch response dispatched to the watcher.
+type WatchResponseInterceptor struct {
+}
+
+func (w *WatchResponseInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	resp, ok := invocation.Args()[0].(*clientv3.WatchResponse)
+	if !ok || resp == nil || resp.Created || resp.IsProgressNotify() {
+		return nil
+	}
+	// only the watch stream created by the traced watcher has the peer
+	if instancePeer(invocation.CallerInstance()) == "" {
+		return nil
+	}
+	// the response is dispatched in the goroutine of the watch stream, should not be linked to the watcher,
+	// the gRPC calls of the stream are still ignored after cleaning the context
+	tracing.CleanContext()
+	ignoreGRPC()
+	span, err := tracing.CreateLocalSpan(etcdPrefix+"WatchResponse",
+		tracing.WithLayer(tracing.SpanLayerDatabase),
+		tracing.WithComponent(etcdComponentID),
+		tracing.WithTag(tracing.TagDBType, etcdDBType),
+		tracing.WithTag(tagEtcdRevision, fmt.Sprintf("%d", resp.Header.Revision)),
+		tracing.WithTag(tagEtcdEvents, fmt.Sprintf("%d", len(resp.Events))),
+	)
+	if err != nil {
+		return nil
+	}
+	if config.CollectKey {
+		tagKeys(span, eventKeys(resp.Events))
+	}
+	if err := resp.Err(); err != nil {
+		span.Error(err.Error())
+	}
+	invocation.SetContext(span)
+	return nil
+}
+
+func (w *WatchResponseInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if span, ok := invocation.GetContext().(tracing.Span); ok {
+		span.End()
+	}
+	return nil
+}
+
+func eventKeys(events []*clientv3.Event) []string {
+	keys := make([]string, 0, len(events))
+	for _, event := range events {
+		if event != nil && event.Kv != nil {
+			keys = append(keys, string(event.Kv.Key))
+		}
+	}
+	return keys
+}
@@ -0,0 +1,106 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package franzgo
+
+import (
+	"embed"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "franz-go"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "github.com/twmb/franz-go"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return true
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		{
+			PackagePath: "pkg/kgo",
+			At:          instrument.NewStructEnhance("Client"),
+		},
+		{
+			PackagePath: "pkg/kgo",
+			At: instrument.NewStaticMethodEnhance("NewClient",
+				instrument.WithArgsCount(1),
+				instrument.WithArgType(0, "...Opt"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "*Client"),
+				instrument.WithResultType(1, "error"),
+			),
+			Interceptor: "NewClientInterceptor",
+		},
+		// ProduceSync delegates to Produce, so every record is traced only once
+		{
+			PackagePath: "pkg/kgo",
+			At: instrument.NewMethodEnhance("*Client", "Produce",
+				instrument.WithArgsCount(3),
+				instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(1, "*Record"),
+				instrument.WithArgType(2, "func(*Record, error)"),
+				instrument.WithResultCount(0),
+			),
+			Interceptor: "ProduceInterceptor",
+		},
+		{
+			PackagePath: "pkg/kgo",
+			At: instrument.NewMethodEnhance("*Client", "TryProduce",
+				instrument.WithArgsCount(3),
+				instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(1, "*Record"),
+				instrument.WithArgType(2, "func(*Record, error)"),
+				instrument.WithResultCount(0),
+			),
+			Interceptor: "ProduceInterceptor",
+		},
+		// PollFetches delegates to PollRecords, so every record is traced only once
+		{
+			PackagePath: "pkg/kgo",
+			At: instrument.NewMethodEnhance("*Client", "PollRecords",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(1, "int"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "Fetches"),
+			),
+			Interceptor: "PollRecordsInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
@@ -0,0 +1,82 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kgo
+
+import (
+	"net"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+const (
+	kafkaDefaultPort = "9092"
+	semicolon        = ";"
+)
+
+// clientInfo is bound to the client when it's created, the seed brokers are used as the peer of the spans
+type clientInfo struct {
+	peer         string
+	group        string
+	defaultTopic string
+}
+
+type NewClientInterceptor struct {
+}
+
+func (n *NewClientInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *NewClientInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	client, ok := result[0].(*nativeClient)
+	if !ok || client == nil {
+		return nil
+	}
+	if instance, ok := result[0].(operator.EnhancedInstance); ok {
+		instance.SetSkyWalkingDynamicField(&clientInfo{
+			peer:         seedBrokerAddresses(client.cfg.seedBrokers),
+			group:        client.cfg.group,
+			defaultTopic: client.cfg.defaultProduceTopic,
+		})
+	}
+	return nil
+}
+
+// seedBrokerAddresses joins the seed brokers, the seeds without port are using the default Kafka port
+func seedBrokerAddresses(seeds []string) string {
+	addresses := make([]string, 0, len(seeds))
+	for _, seed := range seeds {
+		if _, _, err := net.SplitHostPort(seed); err != nil {
+			seed = net.JoinHostPort(seed, kafkaDefaultPort)
+		}
+		addresses = append(addresses, seed)
+	}
+	return strings.Join(addresses, semicolon)
+}
+
+func getClientInfo(client interface{}) *clientInfo {
+	instance, ok := client.(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return &clientInfo{}
+	}
+	if info, ok := instance.GetSkyWalkingDynamicField().(*clientInfo); ok && info != nil {
+		return info
+	}
+	return &clientInfo{}
+}
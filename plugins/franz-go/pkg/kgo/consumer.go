@@ -0,0 +1,85 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kgo
+
+import (
+	"strconv"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	kafkaConsumerPrefix      = "Kafka/"
+	kafkaConsumerSuffix      = "/Consumer"
+	kafkaConsumerComponentID = 41
+	tagMQConsumerGroup       = "mq.consumer_group"
+)
+
+// PollRecordsInterceptor creates an entry span for each polled record, continuing the trace of the producer.
+type PollRecordsInterceptor struct {
+}
+
+func (p *PollRecordsInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (p *PollRecordsInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	fetches, ok := result[0].(kgo.Fetches)
+	if !ok || len(fetches) == 0 {
+		return nil
+	}
+	info := getClientInfo(invocation.CallerInstance())
+	var lastErr error
+	fetches.EachRecord(func(record *kgo.Record) {
+		if err := traceRecord(info, record); err != nil {
+			lastErr = err
+		}
+	})
+	return lastErr
+}
+
+func traceRecord(info *clientInfo, record *kgo.Record) error {
+	opts := []tracing.SpanOption{
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(kafkaConsumerComponentID),
+		tracing.WithTag(tracing.TagMQBroker, info.peer),
+		tracing.WithTag(tracing.TagMQTopic, record.Topic),
+		tracing.WithTag(tagMQPartition, strconv.FormatInt(int64(record.Partition), 10)),
+		tracing.WithTag(tagMQOffset, strconv.FormatInt(record.Offset, 10)),
+	}
+	if info.group != "" {
+		opts = append(opts, tracing.WithTag(tagMQConsumerGroup, info.group))
+	}
+	span, err := tracing.CreateEntrySpan(kafkaConsumerPrefix+record.Topic+kafkaConsumerSuffix, func(headerKey string) (string, error) {
+		for _, header := range record.Headers {
+			if header.Key == headerKey {
+				return string(header.Value), nil
+			}
+		}
+		return "", nil
+	}, opts...)
+	if err != nil {
+		return err
+	}
+	span.SetPeer(info.peer)
+	span.End()
+	return nil
+}
@@ -0,0 +1,104 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kgo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	agentv3 "github.com/apache/skywalking-go/protocols/collect/language/agent/v3"
+)
+
+// the native client is enhanced by the agent, provide the client info for testing
+func (c *nativeClient) GetSkyWalkingDynamicField() interface{} {
+	return &clientInfo{peer: "broker:9092", group: "test-group"}
+}
+
+func (c *nativeClient) SetSkyWalkingDynamicField(interface{}) {
+}
+
+func TestPollRecordsPropagation(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	produced := &kgo.Record{Topic: "poll-topic"}
+	span, err := createProducerSpan("broker:9092", produced.Topic, produced)
+	if err != nil {
+		t.Fatal(err)
+	}
+	span.End()
+	producer := findReportedSpan(t, "Kafka/poll-topic/Producer")
+	core.ResetTracingContext()
+
+	fetches := kgo.Fetches{{Topics: []kgo.FetchTopic{{
+		Topic: "poll-topic",
+		Partitions: []kgo.FetchPartition{{
+			Partition: 3,
+			Records: []*kgo.Record{
+				{Topic: "poll-topic", Partition: 3, Offset: 7, Headers: produced.Headers},
+				{Topic: "poll-topic", Partition: 3, Offset: 8},
+			},
+		}},
+	}}}}
+	interceptor := &PollRecordsInterceptor{}
+	invocation := operator.NewInvocation(&nativeClient{}, context.Background(), 10)
+	if err = interceptor.AfterInvoke(invocation, fetches); err != nil {
+		t.Fatal(err)
+	}
+
+	findReportedSpan(t, "Kafka/poll-topic/Consumer")
+	time.Sleep(100 * time.Millisecond)
+	consumers := make(map[string]map[string]string)
+	linked := 0
+	for _, consumer := range core.GetReportedSpans() {
+		if consumer.OperationName() != "Kafka/poll-topic/Consumer" {
+			continue
+		}
+		tags := reportedTags(consumer)
+		consumers[tags["mq.offset"]] = tags
+		for _, ref := range consumer.Refs() {
+			if ref.GetTraceID() == producer.Context().GetTraceID() &&
+				ref.GetParentSegmentID() == producer.Context().GetSegmentID() {
+				linked++
+			}
+		}
+		if consumer.SpanType() != agentv3.SpanType_Entry || consumer.Peer() != "broker:9092" {
+			t.Errorf("the consumer span should be an entry span from the broker")
+		}
+	}
+	if len(consumers) != 2 {
+		t.Fatalf("expected an entry span for each polled record, actual %d", len(consumers))
+	}
+	if linked != 1 {
+		t.Errorf("only the record with the propagated header should be linked to the producer, actual %d", linked)
+	}
+	for offset, tags := range consumers {
+		expected := map[string]string{"mq.broker": "broker:9092", "mq.topic": "poll-topic", "mq.partition": "3",
+			"mq.offset": offset, "mq.consumer_group": "test-group"}
+		for k, v := range expected {
+			if tags[k] != v {
+				t.Errorf("expected consumer tag %s=%s, actual %s", k, v, tags[k])
+			}
+		}
+	}
+}
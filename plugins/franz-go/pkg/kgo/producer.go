@@ -0,0 +1,110 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kgo
+
+import (
+	"strconv"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	kafkaProducerPrefix      = "Kafka/"
+	kafkaProducerSuffix      = "/Producer"
+	kafkaProducerComponentID = 40
+	tagMQPartition           = "mq.partition"
+	tagMQOffset              = "mq.offset"
+)
+
+// ProduceInterceptor creates an exit span for each record, the record is sent in the background,
+// so the span is finished asynchronously when the promise of the record is called.
+type ProduceInterceptor struct {
+}
+
+func (p *ProduceInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	record, ok := invocation.Args()[1].(*kgo.Record)
+	if !ok || record == nil {
+		return nil
+	}
+	info := getClientInfo(invocation.CallerInstance())
+	topic := record.Topic
+	if topic == "" {
+		topic = info.defaultTopic
+	}
+	span, err := createProducerSpan(info.peer, topic, record)
+	if err != nil {
+		return err
+	}
+	// the promise could be called before the method returns, so the span must be async before sending
+	span.PrepareAsync()
+	span.End()
+
+	promise, _ := invocation.Args()[2].(func(*kgo.Record, error))
+	invocation.ChangeArg(2, func(r *kgo.Record, err error) {
+		finishProducerSpan(span, r, err)
+		if promise != nil {
+			promise(r, err)
+		}
+	})
+	return nil
+}
+
+func (p *ProduceInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+func createProducerSpan(peer, topic string, record *kgo.Record) (tracing.Span, error) {
+	return tracing.CreateExitSpan(kafkaProducerPrefix+topic+kafkaProducerSuffix, peer, func(headerKey, headerValue string) error {
+		record.Headers = setRecordHeader(record.Headers, headerKey, headerValue)
+		return nil
+	},
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(kafkaProducerComponentID),
+		tracing.WithTag(tracing.TagMQBroker, peer),
+		tracing.WithTag(tracing.TagMQTopic, topic),
+	)
+}
+
+func finishProducerSpan(span tracing.Span, record *kgo.Record, err error) {
+	defer func() {
+		// the promise is called in the client goroutine, never break it
+		_ = recover()
+	}()
+	if err != nil {
+		span.Tag(tracing.TagMQStatus, err.Error())
+		span.Error(err.Error())
+	} else if record != nil {
+		span.Tag(tagMQPartition, strconv.FormatInt(int64(record.Partition), 10))
+		span.Tag(tagMQOffset, strconv.FormatInt(record.Offset, 10))
+	}
+	span.AsyncFinish()
+}
+
+// setRecordHeader replace the header if exists, for the record may be produced more than once
+func setRecordHeader(headers []kgo.RecordHeader, key, value string) []kgo.RecordHeader {
+	for i := range headers {
+		if headers[i].Key == key {
+			headers[i].Value = []byte(value)
+			return headers
+		}
+	}
+	return append(headers, kgo.RecordHeader{Key: key, Value: []byte(value)})
+}
@@ -0,0 +1,144 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+)
+
+func findReportedSpan(t *testing.T, name string) reporter.ReportedSpan {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		for _, s := range core.GetReportedSpans() {
+			if s.OperationName() == name {
+				return s
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("span %q was never reported", name)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func reportedTags(s reporter.ReportedSpan) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range s.Tags() {
+		tags[tag.Key] = tag.Value
+	}
+	return tags
+}
+
+func TestSeedBrokerAddresses(t *testing.T) {
+	actual := seedBrokerAddresses([]string{"kafka-1:9093", "kafka-2", "::1"})
+	if expected := "kafka-1:9093;kafka-2:9092;[::1]:9092"; actual != expected {
+		t.Errorf("expected %s, actual %s", expected, actual)
+	}
+}
+
+func TestSetRecordHeader(t *testing.T) {
+	headers := setRecordHeader(nil, "sw8", "first")
+	headers = setRecordHeader(headers, "custom", "value")
+	// the record produced again should not contain the duplicate header
+	headers = setRecordHeader(headers, "sw8", "second")
+	if len(headers) != 2 {
+		t.Fatalf("expected 2 headers, actual %d", len(headers))
+	}
+	if headers[0].Key != "sw8" || string(headers[0].Value) != "second" {
+		t.Errorf("unexpected header %s=%s", headers[0].Key, headers[0].Value)
+	}
+}
+
+func TestProduceRecordPropagation(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	record := &kgo.Record{Topic: "test-topic"}
+	span, err := createProducerSpan("broker:9092", record.Topic, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	span.PrepareAsync()
+	span.End()
+
+	// the promise is called by the client goroutine
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		record.Partition, record.Offset = 1, 10
+		finishProducerSpan(span, record, nil)
+		if err := traceRecord(&clientInfo{peer: "broker:9092", group: "test-group"}, record); err != nil {
+			t.Error(err)
+		}
+	}()
+	<-done
+
+	producer := findReportedSpan(t, "Kafka/test-topic/Producer")
+	consumer := findReportedSpan(t, "Kafka/test-topic/Consumer")
+	if len(consumer.Refs()) != 1 {
+		t.Fatalf("expected 1 ref of the consumer span, actual %d", len(consumer.Refs()))
+	}
+	if ref := consumer.Refs()[0]; ref.GetTraceID() != producer.Context().GetTraceID() ||
+		ref.GetParentSegmentID() != producer.Context().GetSegmentID() {
+		t.Errorf("the consumer span is not linked to the producer span")
+	}
+	expected := map[string]string{"mq.topic": "test-topic", "mq.partition": "1", "mq.offset": "10"}
+	producerTags := reportedTags(producer)
+	for k, v := range expected {
+		if producerTags[k] != v {
+			t.Errorf("expected producer tag %s=%s, actual %s", k, v, producerTags[k])
+		}
+	}
+	expected["mq.consumer_group"] = "test-group"
+	consumerTags := reportedTags(consumer)
+	for k, v := range expected {
+		if consumerTags[k] != v {
+			t.Errorf("expected consumer tag %s=%s, actual %s", k, v, consumerTags[k])
+		}
+	}
+}
+
+func TestProduceRecordFailed(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	record := &kgo.Record{Topic: "failed-topic"}
+	span, err := createProducerSpan("broker:9092", record.Topic, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	span.PrepareAsync()
+	span.End()
+	finishProducerSpan(span, record, errors.New("produce failed"))
+
+	producer := findReportedSpan(t, "Kafka/failed-topic/Producer")
+	if !producer.IsError() {
+		t.Error("the produce error should be recorded on the producer span")
+	}
+	if _, ok := reportedTags(producer)["mq.offset"]; ok {
+		t.Error("the failed record should not carry the offset tag")
+	}
+}
@@ -2,9 +2,15 @@ module github.com/apache/skywalking-go/plugins/go-redisv9
 
 go 1.24
 
-require github.com/redis/go-redis/v9 v9.0.5
+require (
+	github.com/redis/go-redis/v9 v9.0.5
+	github.com/stretchr/testify v1.9.0
+)
 
 require (
 	github.com/cespare/xxhash/v2 v2.2.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
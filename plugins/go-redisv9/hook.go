@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/apache/skywalking-go/plugins/core/tracing"
 
@@ -113,7 +114,10 @@ func (r *redisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
 			defer s.End()
 		}
 
-		if err = next(ctx, cmd); err != nil {
+		start := time.Now()
+		err = next(ctx, cmd)
+		recordCommand(r.Addr, cmd.FullName(), float64(time.Since(start).Microseconds())/1000, err)
+		if err != nil {
 			recordError(s, err)
 			return err
 		}
@@ -164,7 +168,10 @@ func (r *redisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.Pr
 			defer s.End()
 		}
 
-		if err = next(ctx, cmds); err != nil {
+		start := time.Now()
+		err = next(ctx, cmds)
+		recordCommand(r.Addr, "pipeline", float64(time.Since(start).Microseconds())/1000, err)
+		if err != nil {
 			recordError(s, err)
 			return err
 		}
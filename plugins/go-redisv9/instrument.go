@@ -99,6 +99,20 @@ func (i *Instrument) Points() []*instrument.Point {
 				instrument.WithResultType(0, "*Ring")),
 			Interceptor: "GoRedisInterceptor",
 		},
+		{
+			PackageName: "redis",
+			PackagePath: "",
+			At:          instrument.NewStructEnhance("baseClient"),
+		},
+		{
+			PackageName: "redis",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*baseClient", "Close",
+				instrument.WithArgsCount(0),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error")),
+			Interceptor: "CloseInterceptor",
+		},
 	}
 }
 
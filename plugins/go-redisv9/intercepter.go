@@ -43,17 +43,20 @@ func (g *GoRedisInterceptor) AfterInvoke(invocation operator.Invocation, result
 	switch c := rdb.(type) {
 	case *redis.Client:
 		c.AddHook(newRedisHook(c.Options().Addr))
+		registerPoolMetrics(c)
 	case *redis.ClusterClient:
 		c.AddHook(newRedisHook(""))
 
 		c.OnNewNode(func(rdb *redis.Client) {
 			rdb.AddHook(newRedisHook(rdb.Options().Addr))
+			registerPoolMetrics(rdb)
 		})
 	case *redis.Ring:
 		c.AddHook(newRedisHook(""))
 
 		c.OnNewNode(func(rdb *redis.Client) {
 			rdb.AddHook(newRedisHook(rdb.Options().Addr))
+			registerPoolMetrics(rdb)
 		})
 	default:
 		return fmt.Errorf("go-redis :skyWalking cannot create hook for the unsupported client type: %T", c)
@@ -61,3 +64,22 @@ func (g *GoRedisInterceptor) AfterInvoke(invocation operator.Invocation, result
 
 	return nil
 }
+
+type CloseInterceptor struct {
+}
+
+func (c *CloseInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+// AfterInvoke unregisters the closed client from the pool metrics, the client is kept in the enhanced base client
+func (c *CloseInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	instance, ok := invocation.CallerInstance().(operator.EnhancedInstance)
+	if !ok {
+		return nil
+	}
+	if client, ok := instance.GetSkyWalkingDynamicField().(*redis.Client); ok && client != nil {
+		unregisterPoolMetrics(client)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package goredisv9
+
+import (
+	"sync"
+
+	"github.com/apache/skywalking-go/plugins/core/metrics"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// the latency buckets of the redis command, in milliseconds
+var commandLatencyBuckets = []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000}
+
+var (
+	poolMetricsLock sync.Mutex
+	poolMetricsMap  = make(map[string]*poolMetrics)
+
+	commandMetricsLock sync.RWMutex
+	commandMetricsMap  = make(map[string]*commandMetrics)
+)
+
+// poolMetrics reports the connection pool stats of the clients,
+// the clients with the same address are aggregated into the same meters
+type poolMetrics struct {
+	clients []*redis.Client
+	stats   redis.PoolStats
+}
+
+type commandMetrics struct {
+	latency metrics.Histogram
+	errors  metrics.Counter
+}
+
+func registerPoolMetrics(client *redis.Client) {
+	addr := client.Options().Addr
+	if addr == "" {
+		return
+	}
+	// the client is kept in the enhanced base client, so it could be unregistered when closing
+	var enhanced interface{} = client
+	if instance, ok := enhanced.(operator.EnhancedInstance); ok {
+		instance.SetSkyWalkingDynamicField(client)
+	}
+	poolMetricsLock.Lock()
+	defer poolMetricsLock.Unlock()
+	if p, exist := poolMetricsMap[addr]; exist {
+		p.clients = append(p.clients, client)
+		return
+	}
+	p := &poolMetrics{clients: []*redis.Client{client}}
+	poolMetricsMap[addr] = p
+
+	opt := metrics.WithLabel("peer", addr)
+	p.gauge("instance_golang_redis_pool_hits", func(s *redis.PoolStats) uint32 { return s.Hits }, opt)
+	p.gauge("instance_golang_redis_pool_misses", func(s *redis.PoolStats) uint32 { return s.Misses }, opt)
+	p.gauge("instance_golang_redis_pool_timeouts", func(s *redis.PoolStats) uint32 { return s.Timeouts }, opt)
+	p.gauge("instance_golang_redis_pool_total_connections", func(s *redis.PoolStats) uint32 { return s.TotalConns }, opt)
+	p.gauge("instance_golang_redis_pool_idle_connections", func(s *redis.PoolStats) uint32 { return s.IdleConns }, opt)
+	p.gauge("instance_golang_redis_pool_stale_connections", func(s *redis.PoolStats) uint32 { return s.StaleConns }, opt)
+	metrics.RegisterBeforeCollectHook(p.collect)
+}
+
+// unregisterPoolMetrics removes the closed client from the pool metrics,
+// the meters are kept and report the stats of the remaining clients
+func unregisterPoolMetrics(client *redis.Client) {
+	poolMetricsLock.Lock()
+	defer poolMetricsLock.Unlock()
+	p := poolMetricsMap[client.Options().Addr]
+	if p == nil {
+		return
+	}
+	for i, c := range p.clients {
+		if c != client {
+			continue
+		}
+		clients := make([]*redis.Client, 0, len(p.clients)-1)
+		clients = append(clients, p.clients[:i]...)
+		p.clients = append(clients, p.clients[i+1:]...)
+		return
+	}
+}
+
+func (p *poolMetrics) gauge(name string, getter func(s *redis.PoolStats) uint32, opt metrics.Opt) {
+	metrics.NewGauge(name, func() float64 {
+		return float64(getter(&p.stats))
+	}, opt)
+}
+
+func (p *poolMetrics) collect() {
+	poolMetricsLock.Lock()
+	clients := p.clients
+	poolMetricsLock.Unlock()
+
+	result := redis.PoolStats{}
+	for _, client := range clients {
+		stats := client.PoolStats()
+		if stats == nil {
+			continue
+		}
+		result.Hits += stats.Hits
+		result.Misses += stats.Misses
+		result.Timeouts += stats.Timeouts
+		result.TotalConns += stats.TotalConns
+		result.IdleConns += stats.IdleConns
+		result.StaleConns += stats.StaleConns
+	}
+	p.stats = result
+}
+
+// recordCommand records the latency and error of the command, no matter whether the trace is sampled.
+// The commands of the cluster and ring clients are recorded by the hooks of their node clients with the node address
+func recordCommand(addr, cmd string, costMillis float64, err error) {
+	if addr == "" {
+		return
+	}
+	cm := getCommandMetrics(addr, cmd)
+	cm.latency.Observe(costMillis)
+	if err != nil && err != redis.Nil {
+		cm.errors.Inc(1)
+	}
+}
+
+// getCommandMetrics returns the meters of the command, the meters are created only once for the same command
+func getCommandMetrics(addr, cmd string) *commandMetrics {
+	key := addr + "/" + cmd
+	commandMetricsLock.RLock()
+	cm := commandMetricsMap[key]
+	commandMetricsLock.RUnlock()
+	if cm != nil {
+		return cm
+	}
+
+	commandMetricsLock.Lock()
+	defer commandMetricsLock.Unlock()
+	if cm = commandMetricsMap[key]; cm != nil {
+		return cm
+	}
+	opts := []metrics.Opt{metrics.WithLabel("peer", addr), metrics.WithLabel("cmd", cmd)}
+	cm = &commandMetrics{
+		latency: metrics.NewHistogram("instance_golang_redis_command_latency", commandLatencyBuckets, opts...),
+		errors:  metrics.NewCounter("instance_golang_redis_command_errors", opts...),
+	}
+	commandMetricsMap[key] = cm
+	return cm
+}
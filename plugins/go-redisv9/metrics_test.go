@@ -0,0 +1,147 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package goredisv9
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+type testHistogram interface {
+	Buckets() []interface{}
+}
+
+type testBucket interface {
+	Bucket() float64
+	Value() *int64
+}
+
+type enhancedInstance struct {
+	field interface{}
+}
+
+func (e *enhancedInstance) GetSkyWalkingDynamicField() interface{} {
+	return e.field
+}
+
+func (e *enhancedInstance) SetSkyWalkingDynamicField(v interface{}) {
+	e.field = v
+}
+
+func histogramCount(t *testing.T, h interface{}) int64 {
+	histogram, ok := h.(testHistogram)
+	if !assert.True(t, ok, "unknown histogram: %T", h) {
+		return 0
+	}
+	var count int64
+	for _, b := range histogram.Buckets() {
+		count += *b.(testBucket).Value()
+	}
+	return count
+}
+
+func TestRecordCommand(t *testing.T) {
+	recordCommand("record:6379", "get", 3, nil)
+	recordCommand("record:6379", "get", 30, errors.New("timeout"))
+	recordCommand("record:6379", "get", 1, redis.Nil)
+	recordCommand("", "get", 1, errors.New("no address"))
+
+	cm := getCommandMetrics("record:6379", "get")
+	assert.Equal(t, int64(3), histogramCount(t, cm.latency))
+	assert.Equal(t, float64(1), cm.errors.Get(), "the redis.Nil should not be an error")
+
+	commandMetricsLock.RLock()
+	_, exist := commandMetricsMap["/get"]
+	commandMetricsLock.RUnlock()
+	assert.False(t, exist, "the command without address should be ignored")
+}
+
+func TestCommandMetricsCreatedOnce(t *testing.T) {
+	var wg sync.WaitGroup
+	results := make([]*commandMetrics, 20)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = getCommandMetrics("concurrent:6379", "set")
+		}(i)
+	}
+	wg.Wait()
+	for _, cm := range results {
+		assert.Same(t, results[0], cm)
+	}
+}
+
+func TestPoolMetricsUnregisterWhenClosed(t *testing.T) {
+	client1 := redis.NewClient(&redis.Options{Addr: "closing:6379"})
+	client2 := redis.NewClient(&redis.Options{Addr: "closing:6379"})
+	defer client2.Close()
+	registerPoolMetrics(client1)
+	registerPoolMetrics(client2)
+
+	p := poolMetricsMap["closing:6379"]
+	if !assert.NotNil(t, p) {
+		return
+	}
+	assert.Len(t, p.clients, 2)
+
+	interceptor := &CloseInterceptor{}
+	instance := &enhancedInstance{field: client1}
+	assert.Nil(t, interceptor.BeforeInvoke(operator.NewInvocation(instance)))
+	assert.Nil(t, client1.Close())
+	assert.Nil(t, interceptor.AfterInvoke(operator.NewInvocation(instance), nil))
+	assert.Equal(t, []*redis.Client{client2}, p.clients)
+
+	// the base client which is not registered should be ignored
+	assert.Nil(t, interceptor.AfterInvoke(operator.NewInvocation(&enhancedInstance{}), nil))
+	assert.Len(t, p.clients, 1)
+}
+
+func TestClusterCommandsRecordedWithNodeAddress(t *testing.T) {
+	client := redis.NewClusterClient(&redis.ClusterOptions{
+		Addrs:       []string{"127.0.0.1:1"},
+		DialTimeout: 100 * time.Millisecond,
+		MaxRetries:  -1,
+	})
+	defer client.Close()
+	assert.Nil(t, (&GoRedisInterceptor{}).AfterInvoke(operator.NewInvocation(nil), client))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NotNil(t, client.Get(ctx, "key").Err())
+
+	commandMetricsLock.RLock()
+	defer commandMetricsLock.RUnlock()
+	recorded := false
+	for key, cm := range commandMetricsMap {
+		assert.NotEqual(t, "/get", key, "the cluster client should not record the command without address")
+		if len(key) > len("127.0.0.1:1/") && key[:len("127.0.0.1:1/")] == "127.0.0.1:1/" {
+			recorded = true
+			assert.GreaterOrEqual(t, cm.errors.Get(), float64(1))
+		}
+	}
+	assert.True(t, recorded, "the command should be recorded with the node address")
+}
@@ -0,0 +1,109 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gozero
+
+import (
+	"embed"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "go-zero"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "github.com/zeromicro/go-zero"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return true
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		{
+			PackagePath: "rest",
+			At: instrument.NewMethodEnhance("*engine", "bindRoute",
+				instrument.WithArgsCount(5),
+				instrument.WithArgType(1, "httpx.Router"),
+				instrument.WithArgType(3, "Route"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error")),
+			Interceptor: "BindRouteInterceptor",
+		},
+		{
+			PackagePath: "zrpc/internal/clientinterceptors",
+			At: instrument.NewStaticMethodEnhance("UnaryTracingInterceptor",
+				instrument.WithArgsCount(7),
+				instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(1, "string"),
+				instrument.WithArgType(4, "*grpc.ClientConn"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error")),
+			Interceptor: "UnaryClientInterceptor",
+		},
+		{
+			PackagePath: "zrpc/internal/clientinterceptors",
+			At: instrument.NewStaticMethodEnhance("StreamTracingInterceptor",
+				instrument.WithArgsCount(6),
+				instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(2, "*grpc.ClientConn"),
+				instrument.WithArgType(3, "string"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "grpc.ClientStream"),
+				instrument.WithResultType(1, "error")),
+			Interceptor: "StreamClientInterceptor",
+		},
+		{
+			PackagePath: "zrpc/internal/serverinterceptors",
+			At: instrument.NewStaticMethodEnhance("UnaryTracingInterceptor",
+				instrument.WithArgsCount(4),
+				instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(2, "*grpc.UnaryServerInfo"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(1, "error")),
+			Interceptor: "UnaryServerInterceptor",
+		},
+		{
+			PackagePath: "zrpc/internal/serverinterceptors",
+			At: instrument.NewStaticMethodEnhance("StreamTracingInterceptor",
+				instrument.WithArgsCount(4),
+				instrument.WithArgType(1, "grpc.ServerStream"),
+				instrument.WithArgType(2, "*grpc.StreamServerInfo"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error")),
+			Interceptor: "StreamServerInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
@@ -0,0 +1,127 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/apache/skywalking-go/plugins/core/log"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type BindRouteInterceptor struct {
+}
+
+// BeforeInvoke wraps the router before the route is bound, the handler passed to the router
+// already contains the whole go-zero middleware chain, so the span covers all of them.
+func (b *BindRouteInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	httpRouter, ok := invocation.Args()[1].(httpx.Router)
+	if !ok || httpRouter == nil {
+		return nil
+	}
+	invocation.ChangeArg(1, &tracingRouter{Router: httpRouter})
+	return nil
+}
+
+func (b *BindRouteInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+type tracingRouter struct {
+	httpx.Router
+}
+
+func (t *tracingRouter) Handle(method, reqPath string, handler http.Handler) error {
+	return t.Router.Handle(method, reqPath, tracingHandler(method, reqPath, handler))
+}
+
+func tracingHandler(method, reqPath string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// if the entry span is already created by the net/http plugin, it is reused and renamed
+		span, err := tracing.CreateEntrySpan(fmt.Sprintf("%s:%s", method, reqPath), func(headerKey string) (string, error) {
+			return r.Header.Get(headerKey), nil
+		}, tracing.WithLayer(tracing.SpanLayerHTTP),
+			tracing.WithTag(tracing.TagHTTPMethod, r.Method),
+			tracing.WithTag(tracing.TagURL, r.Host+r.URL.Path),
+			tracing.WithComponent(5004))
+		if err != nil {
+			log.Warnf("cannot create entry span: %v", err)
+			next.ServeHTTP(w, r)
+			return
+		}
+		defer span.End()
+
+		writer := newWriterWrapper(w)
+		next.ServeHTTP(wrapResponseWriter(writer), r)
+
+		span.Tag(tracing.TagStatusCode, fmt.Sprintf("%d", writer.statusCode))
+		if writer.statusCode >= 400 {
+			span.ErrorOccured()
+		}
+	})
+}
+
+func wrapResponseWriter(writer *writerWrapper) http.ResponseWriter {
+	if hijacker, ok := writer.ResponseWriter.(http.Hijacker); ok {
+		return &writerWrapperWithHijacker{
+			ResponseWriter: writer,
+			writer:         writer,
+			Hijacker:       hijacker,
+		}
+	}
+	return writer
+}
+
+func newWriterWrapper(writer http.ResponseWriter) *writerWrapper {
+	return &writerWrapper{
+		ResponseWriter: writer,
+		statusCode:     http.StatusOK,
+	}
+}
+
+type writerWrapper struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *writerWrapper) WriteHeader(statusCode int) {
+	// cache the status code
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush keeps the server-sent events of go-zero working through the wrapper.
+func (w *writerWrapper) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+type writerWrapperWithHijacker struct {
+	http.ResponseWriter
+	writer *writerWrapper // status code cache
+	http.Hijacker
+}
+
+func (w *writerWrapperWithHijacker) Flush() {
+	w.writer.Flush()
+}
@@ -0,0 +1,96 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zeromicro/go-zero/rest/router"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestBindRoute(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	invocation := operator.NewInvocation(nil, nil, router.NewRouter(), nil, nil, nil)
+	err := (&BindRouteInterceptor{}).BeforeInvoke(invocation)
+	assert.Nil(t, err, "before invoke error should be nil")
+	wrapped, ok := invocation.Args()[1].(*tracingRouter)
+	assert.True(t, ok, "router should be wrapped")
+
+	err = wrapped.Handle(http.MethodGet, "/users/:id", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	assert.Nil(t, err, "handle error should be nil")
+
+	request := httptest.NewRequest(http.MethodGet, "http://localhost/users/1", http.NoBody)
+	wrapped.ServeHTTP(httptest.NewRecorder(), request)
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GET:/users/:id", spans[0].OperationName(), "operation name should be the route path")
+	assert.Equal(t, int32(5004), spans[0].ComponentID(), "ComponentID should be 5004")
+	assert.Equal(t, "404", tagValue(spans[0], tracing.TagStatusCode), "status code should be 404")
+	assert.Equal(t, "localhost/users/1", tagValue(spans[0], tracing.TagURL), "url should be the request path")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+}
+
+func TestTracingHandlerKeepsWriterInterfaces(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	recorder := httptest.NewRecorder()
+	var flushed bool
+	handler := tracingHandler(http.MethodPost, "/events", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, flushed = w.(http.Flusher)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "http://localhost/events", http.NoBody))
+
+	assert.True(t, flushed, "response writer should be a flusher")
+	assert.Equal(t, "ok", recorder.Body.String(), "body should be written through")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "POST:/events", spans[0].OperationName(), "operation name should be the route path")
+	assert.Equal(t, "200", tagValue(spans[0], tracing.TagStatusCode), "status code should be 200")
+	assert.False(t, spans[0].IsError(), "span should not be marked as error")
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,43 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientinterceptors
+
+import (
+	"path"
+)
+
+var rpcTypeTag = "rpc.type"
+
+func generateOperationName(fullMethod string) string {
+	// "/package.Service/Method" to "package.Service.Method", same as the gRPC plugin
+	name := make([]byte, 0, len(fullMethod))
+	for i := 0; i < len(fullMethod); i++ {
+		if fullMethod[i] != '/' {
+			name = append(name, fullMethod[i])
+		} else if i > 0 {
+			name = append(name, '.')
+		}
+	}
+	return string(name)
+}
+
+// generatePeer trims the scheme of the zRPC target, such as "direct:///127.0.0.1:8080"
+// for the endpoints, or "etcd://127.0.0.1:2379/user.rpc" for the discovery key.
+func generatePeer(target string) string {
+	return path.Base(target)
+}
@@ -0,0 +1,64 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientinterceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type StreamClientInterceptor struct {
+}
+
+func (s *StreamClientInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	ctx := invocation.Args()[0].(context.Context)
+	cc := invocation.Args()[2].(*grpc.ClientConn)
+	method := invocation.Args()[3].(string)
+	// if the exit span is already created by the gRPC plugin, it is reused
+	span, err := tracing.CreateExitSpan(generateOperationName(method), generatePeer(cc.Target()), func(headerKey, headerValue string) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, headerKey, headerValue)
+		invocation.ChangeArg(0, ctx)
+		return nil
+	}, tracing.WithLayer(tracing.SpanLayerRPCFramework),
+		tracing.WithTag(tracing.TagURL, method),
+		tracing.WithComponent(23))
+	if err != nil {
+		return err
+	}
+	span.Tag(rpcTypeTag, "Streaming")
+	invocation.SetContext(span)
+	return nil
+}
+
+func (s *StreamClientInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	span := invocation.GetContext().(tracing.Span)
+	// the span only covers the establishment of the stream
+	if err, ok := result[1].(error); ok && err != nil {
+		span.Error(err.Error())
+	}
+	span.End()
+	return nil
+}
@@ -0,0 +1,72 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clientinterceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	cc, err := grpc.Dial("direct:///127.0.0.1:8888", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err, "dial error should be nil")
+	defer cc.Close()
+
+	interceptor := &UnaryClientInterceptor{}
+	invocation := operator.NewInvocation(nil, context.Background(), "/api.Echo/Hello", nil, nil, cc, nil, nil)
+	err = interceptor.BeforeInvoke(invocation)
+	assert.Nil(t, err, "before invoke error should be nil")
+
+	md, _ := metadata.FromOutgoingContext(invocation.Args()[0].(context.Context))
+	assert.NotEmpty(t, md.Get(core.Header), "tracing context should be propagated by the metadata")
+
+	err = interceptor.AfterInvoke(invocation, errors.New("test error"))
+	assert.Nil(t, err, "after invoke error should be nil")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "api.Echo.Hello", spans[0].OperationName(), "operation name should be api.Echo.Hello")
+	assert.Equal(t, "127.0.0.1:8888", spans[0].Peer(), "peer should be the target without scheme")
+	assert.Equal(t, "RPCFramework", spans[0].SpanLayer().String(), "SpanLayer should be RPCFramework")
+	assert.Equal(t, int32(23), spans[0].ComponentID(), "ComponentID should be 23")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+}
+
+func TestGeneratePeer(t *testing.T) {
+	assert.Equal(t, "127.0.0.1:8080", generatePeer("127.0.0.1:8080"))
+	assert.Equal(t, "127.0.0.1:8080,127.0.0.1:8081", generatePeer("direct:///127.0.0.1:8080,127.0.0.1:8081"))
+	assert.Equal(t, "user.rpc", generatePeer("etcd://127.0.0.1:2379/user.rpc"))
+}
@@ -0,0 +1,52 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package serverinterceptors
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+var rpcTypeTag = "rpc.type"
+
+// createServerEntrySpan reuses the entry span if it is already created by the gRPC plugin.
+func createServerEntrySpan(ctx context.Context, fullMethod, rpcType string) (tracing.Span, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	span, err := tracing.CreateEntrySpan(generateOperationName(fullMethod), func(headerKey string) (string, error) {
+		if values := md.Get(headerKey); len(values) > 0 {
+			return values[0], nil
+		}
+		return "", nil
+	}, tracing.WithLayer(tracing.SpanLayerRPCFramework),
+		tracing.WithTag(tracing.TagURL, fullMethod),
+		tracing.WithComponent(23))
+	if err != nil {
+		return nil, err
+	}
+	span.Tag(rpcTypeTag, rpcType)
+	return span, nil
+}
+
+func generateOperationName(fullMethod string) string {
+	// "/package.Service/Method" to "package.Service.Method", same as the gRPC plugin
+	return strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", ".")
+}
@@ -0,0 +1,70 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package serverinterceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	// build the propagated header from an upstream exit span
+	md := metadata.MD{}
+	exitSpan, err := tracing.CreateExitSpan("upstream", "127.0.0.1:8888", func(headerKey, headerValue string) error {
+		md.Set(headerKey, headerValue)
+		return nil
+	})
+	assert.Nil(t, err, "create exit span error should be nil")
+	exitSpan.End()
+	time.Sleep(100 * time.Millisecond)
+	core.ResetTracingContext()
+
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.Echo/Hello"}
+	interceptor := &UnaryServerInterceptor{}
+	invocation := operator.NewInvocation(nil, ctx, nil, info, nil)
+	err = interceptor.BeforeInvoke(invocation)
+	assert.Nil(t, err, "before invoke error should be nil")
+	err = interceptor.AfterInvoke(invocation, nil, errors.New("test error"))
+	assert.Nil(t, err, "after invoke error should be nil")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "api.Echo.Hello", spans[0].OperationName(), "operation name should be api.Echo.Hello")
+	assert.Equal(t, 1, len(spans[0].Refs()), "span should refer to the upstream")
+	assert.Equal(t, int32(23), spans[0].ComponentID(), "ComponentID should be 23")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+}
@@ -0,0 +1,136 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gocql
+
+import (
+	"strings"
+
+	"github.com/gocql/gocql"
+
+	"github.com/apache/skywalking-go/plugins/core/log"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	// the same component as the Cassandra Java driver, which is mapped to the Cassandra server by the OAP
+	cassandraComponentID int32 = 69
+	tagDBConsistency           = "db.consistency"
+	tagDBHost                  = "db.host"
+)
+
+type executionContext struct {
+	span  tracing.Span
+	state *executionState
+}
+
+// ExecuteQueryInterceptor traces every execution of a query, which is shared by
+// Query.Exec, Query.Iter, Query.Scan and other executing methods.
+type ExecuteQueryInterceptor struct {
+}
+
+func (e *ExecuteQueryInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	qry, ok := invocation.Args()[0].(*gocql.Query)
+	if !ok || qry == nil {
+		return nil
+	}
+	statement := ""
+	if config.CollectStatement {
+		statement = qry.Statement()
+	}
+	return createExitSpan(invocation, "Cassandra/Query", qry.Keyspace(), qry.GetConsistency(), statement)
+}
+
+func (e *ExecuteQueryInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	endSpan(invocation, results[0])
+	return nil
+}
+
+// ExecuteBatchInterceptor traces every execution of a batch.
+type ExecuteBatchInterceptor struct {
+}
+
+func (e *ExecuteBatchInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	batch, ok := invocation.Args()[0].(*gocql.Batch)
+	if !ok || batch == nil {
+		return nil
+	}
+	statement := ""
+	if config.CollectStatement {
+		statements := make([]string, 0, len(batch.Entries))
+		for _, entry := range batch.Entries {
+			statements = append(statements, entry.Stmt)
+		}
+		statement = strings.Join(statements, "; ")
+	}
+	return createExitSpan(invocation, "Cassandra/Batch", batch.Keyspace(), batch.GetConsistency(), statement)
+}
+
+func (e *ExecuteBatchInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	endSpan(invocation, results[0])
+	return nil
+}
+
+func createExitSpan(invocation operator.Invocation, operationName, keyspace string,
+	consistency gocql.Consistency, statement string) error {
+	peer := ""
+	if instance, ok := invocation.CallerInstance().(operator.EnhancedInstance); ok && instance != nil {
+		if info, ok := instance.GetSkyWalkingDynamicField().(*sessionInfo); ok && info != nil {
+			peer = info.peer
+		}
+	}
+	opts := []tracing.SpanOption{
+		tracing.WithComponent(cassandraComponentID),
+		tracing.WithLayer(tracing.SpanLayerDatabase),
+		tracing.WithTag(tracing.TagDBType, "Cassandra"),
+		tracing.WithTag(tagDBConsistency, consistency.String()),
+	}
+	if keyspace != "" {
+		opts = append(opts, tracing.WithTag(tracing.TagDBInstance, keyspace))
+	}
+	if statement != "" {
+		opts = append(opts, tracing.WithTag(tracing.TagDBStatement, statement))
+	}
+	span, err := tracing.CreateExitSpan(operationName, peer, func(headerKey, headerValue string) error {
+		return nil
+	}, opts...)
+	if err != nil {
+		log.Warnf("cannot create exit span on gocql: %v", err)
+		return nil
+	}
+	state := &executionState{}
+	tracing.SetRuntimeContextValue(executionStateKey, state)
+	invocation.SetContext(&executionContext{span: span, state: state})
+	return nil
+}
+
+func endSpan(invocation operator.Invocation, result interface{}) {
+	ctx, ok := invocation.GetContext().(*executionContext)
+	if !ok || ctx == nil {
+		return
+	}
+	tracing.SetRuntimeContextValue(executionStateKey, nil)
+	if !ctx.state.finish(ctx.span) {
+		// the execution failed fast without any attempt reported by the observers, such as no connection
+		// to the hosts, too many statements in the batch or the session closed, the error is kept in the iter
+		if iter, ok := result.(*nativeIter); ok && iter != nil && iter.err != nil {
+			ctx.span.Error(iter.err.Error())
+		}
+	}
+	ctx.span.End()
+}
@@ -0,0 +1,231 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gocql
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type testEnhancedInstance struct {
+	field interface{}
+}
+
+func (t *testEnhancedInstance) GetSkyWalkingDynamicField() interface{} {
+	return t.field
+}
+
+func (t *testEnhancedInstance) SetSkyWalkingDynamicField(v interface{}) {
+	t.field = v
+}
+
+type countingObserver struct {
+	queries int
+	batches int
+}
+
+func (c *countingObserver) ObserveQuery(context.Context, gocql.ObservedQuery) {
+	c.queries++
+}
+
+func (c *countingObserver) ObserveBatch(context.Context, gocql.ObservedBatch) {
+	c.batches++
+}
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestBuildPeer(t *testing.T) {
+	assert.Equal(t, "cassandra-1:9042,cassandra-2:9142", buildPeer([]string{"cassandra-1", "cassandra-2:9142"}, 0))
+	assert.Equal(t, "10.0.0.1:19042", buildPeer([]string{"10.0.0.1"}, 19042))
+}
+
+func TestNewSessionInterceptor(t *testing.T) {
+	configured := &countingObserver{}
+	cfg := gocql.NewCluster("cassandra-1", "cassandra-2")
+	cfg.QueryObserver = configured
+	invocation := operator.NewInvocation(nil, *cfg)
+	interceptor := &NewSessionInterceptor{}
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+
+	changed := invocation.Args()[0].(gocql.ClusterConfig)
+	observer, ok := changed.QueryObserver.(*queryObserver)
+	assert.True(t, ok, "query observer should be installed")
+	assert.Equal(t, configured, observer.delegate)
+	_, ok = changed.BatchObserver.(*batchObserver)
+	assert.True(t, ok, "batch observer should be installed")
+	observer.ObserveQuery(context.Background(), gocql.ObservedQuery{})
+	assert.Equal(t, 1, configured.queries, "configured observer should be invoked")
+
+	session := &testEnhancedInstance{}
+	assert.Nil(t, interceptor.AfterInvoke(invocation, session, nil))
+	info, ok := session.field.(*sessionInfo)
+	assert.True(t, ok, "session info should be provided")
+	assert.Equal(t, "cassandra-1:9042,cassandra-2:9042", info.peer)
+}
+
+func TestQueryObserverInterceptor(t *testing.T) {
+	interceptor := &QueryObserverInterceptor{}
+	invocation := operator.NewInvocation(nil, nil)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	observer, ok := invocation.Args()[0].(*queryObserver)
+	assert.True(t, ok, "query observer should be installed")
+	assert.Nil(t, observer.delegate)
+
+	invocation = operator.NewInvocation(nil, observer)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Equal(t, observer, invocation.Args()[0], "installed observer should not be wrapped again")
+}
+
+func TestExecuteQueryInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectStatement = true
+	defer func() {
+		config.CollectStatement = false
+	}()
+
+	qry := (&gocql.Session{}).Query("SELECT name FROM users WHERE id = ?", 1).Consistency(gocql.Quorum)
+	session := &testEnhancedInstance{field: &sessionInfo{peer: "cassandra-1:9042,cassandra-2:9042"}}
+	interceptor := &ExecuteQueryInterceptor{}
+	invocation := operator.NewInvocation(session, qry)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.NotNil(t, invocation.GetContext(), "span should be created")
+
+	observer := &queryObserver{}
+	observer.ObserveQuery(context.Background(), gocql.ObservedQuery{
+		Host: (&gocql.HostInfo{}).SetConnectAddress(net.ParseIP("10.0.0.1")), Err: errors.New("timeout"),
+	})
+	observer.ObserveQuery(context.Background(), gocql.ObservedQuery{
+		Host: (&gocql.HostInfo{}).SetConnectAddress(net.ParseIP("10.0.0.2")), Err: errors.New("unavailable"), Attempt: 1,
+	})
+	assert.Nil(t, interceptor.AfterInvoke(invocation, nil))
+	assert.Nil(t, tracing.GetRuntimeContextValue(executionStateKey), "execution state should be cleaned")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "Cassandra/Query", spans[0].OperationName())
+	assert.Equal(t, "cassandra-1:9042,cassandra-2:9042", spans[0].Peer())
+	assert.Equal(t, "10.0.0.2:0", tagValue(spans[0], tagDBHost))
+	assert.Equal(t, cassandraComponentID, spans[0].ComponentID())
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+	assert.Equal(t, "Cassandra", tagValue(spans[0], tracing.TagDBType))
+	assert.Equal(t, "QUORUM", tagValue(spans[0], tagDBConsistency))
+	assert.Equal(t, "SELECT name FROM users WHERE id = ?", tagValue(spans[0], tracing.TagDBStatement))
+	assert.Equal(t, 2, len(spans[0].Logs()), "retry and error should be logged")
+	assert.Equal(t, "attempt 1 on 10.0.0.1:0 failed: timeout", spans[0].Logs()[0].Data[0].Value)
+}
+
+func TestExecuteBatchInterceptor(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	batch := &gocql.Batch{Type: gocql.LoggedBatch, Cons: gocql.One}
+	batch.Query("INSERT INTO users (id, name) VALUES (?, ?)", 1, "skywalking")
+	session := &testEnhancedInstance{field: &sessionInfo{peer: "cassandra-1:9042"}}
+	interceptor := &ExecuteBatchInterceptor{}
+	invocation := operator.NewInvocation(session, batch)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.NotNil(t, invocation.GetContext(), "span should be created")
+
+	(&batchObserver{}).ObserveBatch(context.Background(), gocql.ObservedBatch{
+		Host: (&gocql.HostInfo{}).SetConnectAddress(net.ParseIP("10.0.0.1")),
+	})
+	assert.Nil(t, interceptor.AfterInvoke(invocation, nil))
+	// reported after the execution returned, such as a speculative execution
+	(&batchObserver{}).ObserveBatch(context.Background(), gocql.ObservedBatch{Err: errors.New("late")})
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "Cassandra/Batch", spans[0].OperationName())
+	assert.Equal(t, "cassandra-1:9042", spans[0].Peer())
+	assert.Equal(t, "10.0.0.1:0", tagValue(spans[0], tagDBHost))
+	assert.False(t, spans[0].IsError(), "span should not be marked as error")
+	assert.Equal(t, "ONE", tagValue(spans[0], tagDBConsistency))
+	assert.Equal(t, "", tagValue(spans[0], tracing.TagDBStatement), "statement should not be collected")
+}
+
+func TestExecuteBatchInterceptorRejected(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	batch := &gocql.Batch{Entries: make([]gocql.BatchEntry, gocql.BatchSizeMaximum+1)}
+	interceptor := &ExecuteBatchInterceptor{}
+	invocation := operator.NewInvocation(&testEnhancedInstance{field: &sessionInfo{peer: "cassandra-1:9042"}}, batch)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, interceptor.AfterInvoke(invocation, &nativeIter{err: gocql.ErrTooManyStmts}))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+	assert.Equal(t, "", tagValue(spans[0], tagDBHost), "no host should serve the batch")
+	assert.Equal(t, gocql.ErrTooManyStmts.Error(), spans[0].Logs()[0].Data[0].Key)
+}
+
+func TestExecuteQueryInterceptorWithoutHost(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	qry := (&gocql.Session{}).Query("SELECT name FROM users")
+	interceptor := &ExecuteQueryInterceptor{}
+	invocation := operator.NewInvocation(&testEnhancedInstance{field: &sessionInfo{peer: "cassandra-1:9042"}}, qry)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	// no host is available in the pool, the execution returns without any attempt observed
+	assert.Nil(t, interceptor.AfterInvoke(invocation, &nativeIter{err: gocql.ErrNoConnections}))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+	assert.Equal(t, gocql.ErrNoConnections.Error(), spans[0].Logs()[0].Data[0].Key)
+}
+
+func TestExecuteQueryInterceptorWithoutAttempt(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	qry := (&gocql.Session{}).Query("SELECT name FROM users")
+	interceptor := &ExecuteQueryInterceptor{}
+	invocation := operator.NewInvocation(&testEnhancedInstance{field: &sessionInfo{peer: "cassandra-1:9042"}}, qry)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, interceptor.AfterInvoke(invocation, &nativeIter{}))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.False(t, spans[0].IsError(), "span should not be marked as error")
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
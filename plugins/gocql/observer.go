@@ -0,0 +1,146 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gocql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gocql/gocql"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const executionStateKey = "gocqlExecutionState"
+
+// executionState collects the attempts of one query or batch execution. The
+// speculative executions of gocql run on other goroutines, they share the
+// state through the runtime context copied into them.
+type executionState struct {
+	mu       sync.Mutex
+	finished bool
+	attempts []attemptResult
+}
+
+type attemptResult struct {
+	host string
+	err  error
+}
+
+func (s *executionState) observe(host *gocql.HostInfo, err error) {
+	result := attemptResult{err: err}
+	if host != nil {
+		result.host = host.HostnameAndPort()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// a speculative execution could still report after the execution returned
+	if s.finished {
+		return
+	}
+	s.attempts = append(s.attempts, result)
+}
+
+// finish applies the observed attempts to the span: the failed attempts before
+// the last one are logged as retries, the last one decides the host and the error.
+// It returns false when no attempt has been observed.
+func (s *executionState) finish(span tracing.Span) bool {
+	s.mu.Lock()
+	s.finished = true
+	attempts := s.attempts
+	s.mu.Unlock()
+
+	if len(attempts) == 0 {
+		return false
+	}
+	for i, attempt := range attempts[:len(attempts)-1] {
+		if attempt.err != nil {
+			span.Log("retry", fmt.Sprintf("attempt %d on %s failed: %v", i+1, attempt.host, attempt.err))
+		}
+	}
+	last := attempts[len(attempts)-1]
+	if last.host != "" {
+		span.Tag(tagDBHost, last.host)
+	}
+	if last.err != nil {
+		span.Error(last.err.Error())
+	}
+	return true
+}
+
+func observeAttempt(host *gocql.HostInfo, err error) {
+	if state, ok := tracing.GetRuntimeContextValue(executionStateKey).(*executionState); ok && state != nil {
+		state.observe(host, err)
+	}
+}
+
+type queryObserver struct {
+	delegate gocql.QueryObserver
+}
+
+func (o *queryObserver) ObserveQuery(ctx context.Context, q gocql.ObservedQuery) {
+	if o.delegate != nil {
+		o.delegate.ObserveQuery(ctx, q)
+	}
+	observeAttempt(q.Host, q.Err)
+}
+
+type batchObserver struct {
+	delegate gocql.BatchObserver
+}
+
+func (o *batchObserver) ObserveBatch(ctx context.Context, b gocql.ObservedBatch) {
+	if o.delegate != nil {
+		o.delegate.ObserveBatch(ctx, b)
+	}
+	observeAttempt(b.Host, b.Err)
+}
+
+// QueryObserverInterceptor keeps the attempts observed when the observer of a query is replaced.
+type QueryObserverInterceptor struct {
+}
+
+func (q *QueryObserverInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	observer, _ := invocation.Args()[0].(gocql.QueryObserver)
+	if _, installed := observer.(*queryObserver); !installed {
+		invocation.ChangeArg(0, &queryObserver{delegate: observer})
+	}
+	return nil
+}
+
+func (q *QueryObserverInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	return nil
+}
+
+// BatchObserverInterceptor keeps the attempts observed when the observer of a batch is replaced.
+type BatchObserverInterceptor struct {
+}
+
+func (b *BatchObserverInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	observer, _ := invocation.Args()[0].(gocql.BatchObserver)
+	if _, installed := observer.(*batchObserver); !installed {
+		invocation.ChangeArg(0, &batchObserver{delegate: observer})
+	}
+	return nil
+}
+
+func (b *BatchObserverInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	return nil
+}
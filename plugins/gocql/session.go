@@ -0,0 +1,81 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gocql
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/gocql/gocql"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+// sessionInfo is kept on the enhanced session, the contact points are used as
+// the peer, the host which served the request is reported as a tag.
+type sessionInfo struct {
+	peer string
+}
+
+// NewSessionInterceptor installs the observers which report each attempt to the
+// executing span, the configured observers are still invoked.
+type NewSessionInterceptor struct {
+}
+
+func (n *NewSessionInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	cfg, ok := invocation.Args()[0].(gocql.ClusterConfig)
+	if !ok {
+		return nil
+	}
+	if _, installed := cfg.QueryObserver.(*queryObserver); !installed {
+		cfg.QueryObserver = &queryObserver{delegate: cfg.QueryObserver}
+	}
+	if _, installed := cfg.BatchObserver.(*batchObserver); !installed {
+		cfg.BatchObserver = &batchObserver{delegate: cfg.BatchObserver}
+	}
+	invocation.ChangeArg(0, cfg)
+	return nil
+}
+
+func (n *NewSessionInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if err, ok := results[1].(error); ok && err != nil {
+		return nil
+	}
+	instance, ok := results[0].(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return nil
+	}
+	cfg, _ := invocation.Args()[0].(gocql.ClusterConfig)
+	instance.SetSkyWalkingDynamicField(&sessionInfo{peer: buildPeer(cfg.Hosts, cfg.Port)})
+	return nil
+}
+
+func buildPeer(hosts []string, port int) string {
+	if port <= 0 {
+		port = 9042
+	}
+	peers := make([]string, 0, len(hosts))
+	for _, host := range hosts {
+		if _, _, err := net.SplitHostPort(host); err != nil {
+			host = net.JoinHostPort(host, strconv.Itoa(port))
+		}
+		peers = append(peers, host)
+	}
+	return strings.Join(peers, ",")
+}
@@ -0,0 +1,180 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	graphqlComponentID = 92
+	tagGraphQLQuery    = "graphql.query"
+	tagGraphQLPath     = "graphql.path"
+	operationPrefix    = "GraphQL"
+	resolverPrefix     = "GraphQL/resolve/"
+)
+
+// tracingExtension renames the entry span of the request to the GraphQL operation,
+// and marks the span as error when the response contains any GraphQL error.
+type tracingExtension struct {
+}
+
+func (t *tracingExtension) ExtensionName() string {
+	return "SkyWalkingTracing"
+}
+
+func (t *tracingExtension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t *tracingExtension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	var opCtx *graphql.OperationContext
+	if graphql.HasOperationContext(ctx) {
+		opCtx = graphql.GetOperationContext(ctx)
+	}
+	// the subscription responses are streamed, there is no single request to be traced
+	if opCtx != nil && opCtx.Operation != nil && opCtx.Operation.Operation == "subscription" {
+		return next(ctx)
+	}
+
+	span, err := tracing.CreateEntrySpan(generateOperationName(opCtx), func(headerKey string) (string, error) {
+		if opCtx == nil {
+			return "", nil
+		}
+		return opCtx.Headers.Get(headerKey), nil
+	}, tracing.WithLayer(tracing.SpanLayerHTTP),
+		tracing.WithComponent(graphqlComponentID))
+	if err != nil {
+		return next(ctx)
+	}
+	if config.CollectQuery && opCtx != nil && opCtx.RawQuery != "" {
+		query := opCtx.RawQuery
+		if len(query) > config.QueryLengthThreshold {
+			maxLen := config.QueryLengthThreshold
+			query = query[:maxLen]
+		}
+		span.Tag(tagGraphQLQuery, query)
+	}
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) > 0 {
+		span.Error(resp.Errors.Error())
+	}
+	span.End()
+	return resp
+}
+
+// resolverExtension creates the local span for the resolvers not deeper than the configured depth,
+// the local span of the resolvers slower than the latency threshold is created after resolved.
+type resolverExtension struct {
+}
+
+func (r *resolverExtension) ExtensionName() string {
+	return "SkyWalkingResolverTracing"
+}
+
+func (r *resolverExtension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (r *resolverExtension) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	if config.ResolverDepth > 0 && resolverDepth(fc) <= config.ResolverDepth {
+		span, err := tracing.CreateLocalSpan(resolverPrefix+fc.Object+"."+fc.Field.Name,
+			tracing.WithComponent(graphqlComponentID),
+			tracing.WithTag(tagGraphQLPath, fc.Path().String()))
+		if err != nil {
+			return next(ctx)
+		}
+		res, resolveErr := next(ctx)
+		if resolveErr != nil {
+			span.Error(resolveErr.Error())
+		}
+		span.End()
+		return res, resolveErr
+	}
+
+	if config.ResolverLatencyThreshold <= 0 {
+		return next(ctx)
+	}
+	start := time.Now()
+	res, err := next(ctx)
+	cost := time.Since(start)
+	if cost < time.Duration(config.ResolverLatencyThreshold)*time.Millisecond {
+		return res, err
+	}
+	// the span could not be discarded once created, so it is created after resolved with the measured start time
+	span, spanErr := tracing.CreateLocalSpan(resolverPrefix+fc.Object+"."+fc.Field.Name,
+		tracing.WithStartTime(start.UnixMilli()),
+		tracing.WithComponent(graphqlComponentID),
+		tracing.WithTag(tagGraphQLPath, fc.Path().String()))
+	if spanErr != nil {
+		return res, err
+	}
+	if err != nil {
+		span.Error(err.Error())
+	}
+	span.End()
+	return res, err
+}
+
+func generateOperationName(opCtx *graphql.OperationContext) string {
+	if opCtx == nil {
+		return operationPrefix
+	}
+	if opCtx.Operation == nil {
+		if opCtx.OperationName != "" {
+			return operationPrefix + "/" + opCtx.OperationName
+		}
+		return operationPrefix
+	}
+
+	var operationType string
+	switch opCtx.Operation.Operation {
+	case "mutation":
+		operationType = "mutation"
+	case "subscription":
+		operationType = "subscription"
+	default:
+		operationType = "query"
+	}
+	if opCtx.Operation.Name == "" {
+		return operationPrefix + "/" + operationType
+	}
+	return operationPrefix + "/" + operationType + "/" + opCtx.Operation.Name
+}
+
+// resolverDepth counts the fields from the root, the elements of a list are not counted
+func resolverDepth(fc *graphql.FieldContext) int {
+	depth := 0
+	for current := fc; current != nil; current = current.Parent {
+		if current.Index == nil {
+			depth++
+		}
+	}
+	return depth
+}
@@ -0,0 +1,191 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const testQuery = "query GetUser { user(id: 1) { name } }"
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestInterceptResponse(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectQuery = true
+	config.QueryLengthThreshold = 14
+	defer func() {
+		config.CollectQuery = false
+		config.QueryLengthThreshold = 0
+	}()
+
+	ctx := operationContext("GetUser", &ast.OperationDefinition{Operation: ast.Query, Name: "GetUser"})
+	resp := (&tracingExtension{}).InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+		return &graphql.Response{Errors: gqlerror.List{{Message: "user not found"}}}
+	})
+	assert.NotNil(t, resp)
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GraphQL/query/GetUser", spans[0].OperationName())
+	assert.Equal(t, int32(graphqlComponentID), spans[0].ComponentID())
+	assert.Equal(t, "query GetUser ", tagValue(spans[0], tagGraphQLQuery), "the query should be truncated")
+	assert.True(t, spans[0].IsError(), "the GraphQL errors should mark the span as error")
+}
+
+func TestInterceptResponseRenameEntrySpan(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	entry, err := tracing.CreateEntrySpan("POST:/graphql", func(headerKey string) (string, error) {
+		return "", nil
+	}, tracing.WithLayer(tracing.SpanLayerHTTP), tracing.WithComponent(5004))
+	assert.Nil(t, err)
+	ctx := operationContext("", &ast.OperationDefinition{Operation: ast.Mutation})
+	(&tracingExtension{}).InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+		return &graphql.Response{}
+	})
+	entry.End()
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "the entry span of the request should be reused")
+	assert.Equal(t, "GraphQL/mutation", spans[0].OperationName())
+	assert.Equal(t, int32(5004), spans[0].ComponentID())
+	assert.False(t, spans[0].IsError())
+}
+
+func TestInterceptResponseIgnoreSubscription(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	ctx := operationContext("OnUser", &ast.OperationDefinition{Operation: ast.Subscription, Name: "OnUser"})
+	(&tracingExtension{}).InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+		return &graphql.Response{}
+	})
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, len(core.GetReportedSpans()), "the subscription should not be traced")
+}
+
+func TestGenerateOperationName(t *testing.T) {
+	assert.Equal(t, "GraphQL", generateOperationName(nil))
+	assert.Equal(t, "GraphQL/GetUser", generateOperationName(&graphql.OperationContext{OperationName: "GetUser"}))
+	assert.Equal(t, "GraphQL/query", generateOperationName(&graphql.OperationContext{
+		Operation: &ast.OperationDefinition{Operation: ast.Query}}))
+	assert.Equal(t, "GraphQL/subscription/OnUser", generateOperationName(&graphql.OperationContext{
+		Operation: &ast.OperationDefinition{Operation: ast.Subscription, Name: "OnUser"}}))
+}
+
+func TestInterceptFieldWithDepth(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.ResolverDepth = 1
+	defer func() {
+		config.ResolverDepth = 0
+	}()
+
+	root := &graphql.FieldContext{Object: "Query", Field: collectedField("user"), IsResolver: true}
+	ctx := graphql.WithFieldContext(context.Background(), root)
+	_, err := (&resolverExtension{}).InterceptField(ctx, func(ctx context.Context) (interface{}, error) {
+		nested := &graphql.FieldContext{Object: "User", Field: collectedField("friends"), IsResolver: true}
+		_, nestedErr := (&resolverExtension{}).InterceptField(graphql.WithFieldContext(ctx, nested),
+			func(ctx context.Context) (interface{}, error) {
+				return nil, nil
+			})
+		assert.Nil(t, nestedErr)
+		return nil, gqlerror.Errorf("user not found")
+	})
+	assert.NotNil(t, err)
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "only the resolvers not deeper than the depth should be traced")
+	assert.Equal(t, "GraphQL/resolve/Query.user", spans[0].OperationName())
+	assert.Equal(t, "user", tagValue(spans[0], tagGraphQLPath))
+	assert.True(t, spans[0].IsError())
+}
+
+func TestInterceptFieldWithLatency(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.ResolverLatencyThreshold = 10
+	defer func() {
+		config.ResolverLatencyThreshold = 0
+	}()
+
+	ctx := operationContext("GetUser", &ast.OperationDefinition{Operation: ast.Query, Name: "GetUser"})
+	start := time.Now()
+	(&tracingExtension{}).InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+		for _, cost := range []time.Duration{0, 20 * time.Millisecond} {
+			fc := &graphql.FieldContext{Object: "Query", Field: collectedField("user"), IsResolver: true}
+			_, _ = (&resolverExtension{}).InterceptField(graphql.WithFieldContext(ctx, fc),
+				func(ctx context.Context) (interface{}, error) {
+					time.Sleep(cost)
+					return nil, nil
+				})
+		}
+		return &graphql.Response{}
+	})
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 2, len(spans), "only the slow resolver should be traced")
+	resolver := spans[0]
+	if resolver.OperationName() != "GraphQL/resolve/Query.user" {
+		resolver = spans[1]
+	}
+	assert.Equal(t, "GraphQL/resolve/Query.user", resolver.OperationName())
+	assert.Equal(t, "user", tagValue(resolver, tagGraphQLPath))
+	assert.GreaterOrEqual(t, resolver.EndTime()-resolver.StartTime(), int64(20),
+		"the span should cover the resolving")
+	assert.GreaterOrEqual(t, resolver.StartTime(), start.UnixMilli())
+}
+
+func operationContext(name string, operation *ast.OperationDefinition) context.Context {
+	return graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+		RawQuery:      testQuery,
+		OperationName: name,
+		Operation:     operation,
+		Headers:       http.Header{},
+	})
+}
+
+func collectedField(name string) graphql.CollectedField {
+	return graphql.CollectedField{Field: &ast.Field{Name: name, Alias: name}}
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,45 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handler
+
+import (
+	"fmt"
+
+	gqlhandler "github.com/99designs/gqlgen/graphql/handler"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+type NewServerInterceptor struct {
+}
+
+func (n *NewServerInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *NewServerInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	server, ok := result[0].(*gqlhandler.Server)
+	if !ok {
+		return fmt.Errorf("gqlgen: cannot register the tracing extension, the server is not *Server: %T", result[0])
+	}
+	server.Use(&tracingExtension{})
+	if config.ResolverDepth > 0 || config.ResolverLatencyThreshold > 0 {
+		server.Use(&resolverExtension{})
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gqlgen
+
+import (
+	"embed"
+	"strconv"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "graphql"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "github.com/99designs/gqlgen"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	// the request headers are kept in the operation context since v0.17.18
+	if !strings.HasPrefix(version, "v0.17.") {
+		return false
+	}
+	patch, _, _ := strings.Cut(strings.TrimPrefix(version, "v0.17."), "-")
+	number, err := strconv.Atoi(patch)
+	return err == nil && number >= 18
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		// NewDefaultServer creates the server through New as well
+		{
+			PackagePath: "graphql/handler",
+			At: instrument.NewStaticMethodEnhance("New",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "graphql.ExecutableSchema"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "*Server")),
+			Interceptor: "NewServerInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) PluginSourceCodePath() string {
+	return "gqlgen"
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
@@ -0,0 +1,81 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package graphqlgo
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "graphql"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "github.com/graph-gophers/graphql-go"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return strings.HasPrefix(version, "v1.")
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		// the type of variables is changed to map[string]any in the newer versions
+		{
+			PackagePath: "",
+			PackageName: "graphql",
+			At: instrument.NewMethodEnhance("*Schema", "Exec",
+				instrument.WithArgsCount(4), instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(1, "string"), instrument.WithArgType(2, "string"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "*Response")),
+			Interceptor: "ExecInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "graphql",
+			At: instrument.NewStaticMethodEnhance("getOperation",
+				instrument.WithArgsCount(2), instrument.WithArgType(0, "*ast.ExecutableDefinition"),
+				instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "*ast.OperationDefinition"),
+				instrument.WithResultType(1, "error")),
+			Interceptor: "GetOperationInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) PluginSourceCodePath() string {
+	return "graphqlgo"
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
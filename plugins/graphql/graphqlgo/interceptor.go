@@ -0,0 +1,118 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package graphqlgo
+
+import (
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/ast"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	graphqlComponentID = 92
+	tagGraphQLQuery    = "graphql.query"
+	operationPrefix    = "GraphQL"
+
+	// operationSpanRuntimeContextKey keeps the span of the executing query,
+	// so it could be renamed when the operation is parsed from the query
+	operationSpanRuntimeContextKey = "graphqlOperationSpan"
+)
+
+type ExecInterceptor struct {
+}
+
+func (e *ExecInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	query := invocation.Args()[1].(string)
+	operationName := operationPrefix
+	if name := invocation.Args()[2].(string); name != "" {
+		operationName = operationPrefix + "/" + name
+	}
+	span, err := tracing.CreateEntrySpan(operationName, func(headerKey string) (string, error) {
+		return "", nil
+	}, tracing.WithLayer(tracing.SpanLayerHTTP),
+		tracing.WithComponent(graphqlComponentID))
+	if err != nil {
+		return err
+	}
+	if config.CollectQuery && query != "" {
+		if len(query) > config.QueryLengthThreshold {
+			maxLen := config.QueryLengthThreshold
+			query = query[:maxLen]
+		}
+		span.Tag(tagGraphQLQuery, query)
+	}
+
+	tracing.SetRuntimeContextValue(operationSpanRuntimeContextKey, span)
+	invocation.SetContext(span)
+	return nil
+}
+
+func (e *ExecInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(operationSpanRuntimeContextKey, nil)
+	span := invocation.GetContext().(tracing.Span)
+	// the errors are responded with the data, so the span should be marked as error by the response
+	if resp, ok := result[0].(*graphql.Response); ok && resp != nil {
+		for _, queryError := range resp.Errors {
+			span.Error(queryError.Error())
+		}
+	}
+	span.End()
+	return nil
+}
+
+type GetOperationInterceptor struct {
+}
+
+func (g *GetOperationInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (g *GetOperationInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	span, ok := tracing.GetRuntimeContextValue(operationSpanRuntimeContextKey).(tracing.Span)
+	if !ok {
+		return nil
+	}
+	if operation, ok := result[0].(*ast.OperationDefinition); ok && operation != nil {
+		span.SetOperationName(generateOperationName(operation, invocation.Args()[1].(string)))
+	}
+	return nil
+}
+
+func generateOperationName(operation *ast.OperationDefinition, operationName string) string {
+	var operationType string
+	switch operation.Type {
+	case "MUTATION":
+		operationType = "mutation"
+	case "SUBSCRIPTION":
+		operationType = "subscription"
+	default:
+		operationType = "query"
+	}
+	if operation.Name.Name != "" {
+		operationName = operation.Name.Name
+	}
+	if operationName == "" {
+		return operationPrefix + "/" + operationType
+	}
+	return operationPrefix + "/" + operationType + "/" + operationName
+}
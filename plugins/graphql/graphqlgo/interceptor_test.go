@@ -0,0 +1,94 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package graphqlgo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/ast"
+	"github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const testQuery = "query GetUser { user(id: 1) { name } }"
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestExec(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectQuery = true
+	config.QueryLengthThreshold = 14
+	defer func() {
+		config.CollectQuery = false
+		config.QueryLengthThreshold = 0
+	}()
+
+	interceptor := &ExecInterceptor{}
+	invocation := operator.NewInvocation(nil, context.Background(), testQuery, "", nil)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+
+	operation := &ast.OperationDefinition{Type: "QUERY", Name: ast.Ident{Name: "GetUser"}}
+	getOperation := operator.NewInvocation(nil, &ast.ExecutableDefinition{}, "")
+	assert.Nil(t, (&GetOperationInterceptor{}).AfterInvoke(getOperation, operation, nil))
+
+	resp := &graphql.Response{Errors: []*errors.QueryError{{Message: "user not found"}}}
+	assert.Nil(t, interceptor.AfterInvoke(invocation, resp))
+	assert.Nil(t, tracing.GetRuntimeContextValue(operationSpanRuntimeContextKey), "the operation span should be cleaned")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GraphQL/query/GetUser", spans[0].OperationName())
+	assert.Equal(t, int32(graphqlComponentID), spans[0].ComponentID())
+	assert.Equal(t, "query GetUser ", spans[0].Tags()[0].Value, "the query should be truncated")
+	assert.True(t, spans[0].IsError(), "the GraphQL errors should mark the span as error")
+}
+
+func TestGetOperationWithoutExec(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	span, err := tracing.CreateEntrySpan("GET:/subscriptions", func(headerKey string) (string, error) {
+		return "", nil
+	})
+	assert.Nil(t, err)
+	operation := &ast.OperationDefinition{Type: "SUBSCRIPTION", Name: ast.Ident{Name: "OnUser"}}
+	invocation := operator.NewInvocation(nil, &ast.ExecutableDefinition{}, "")
+	assert.Nil(t, (&GetOperationInterceptor{}).AfterInvoke(invocation, operation, nil))
+	span.End()
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GET:/subscriptions", spans[0].OperationName(), "only the span of executing query should be renamed")
+}
+
+func TestGenerateOperationName(t *testing.T) {
+	assert.Equal(t, "GraphQL/mutation/CreateUser", generateOperationName(&ast.OperationDefinition{Type: "MUTATION"}, "CreateUser"))
+	assert.Equal(t, "GraphQL/query", generateOperationName(&ast.OperationDefinition{Type: "QUERY"}, ""))
+	assert.Equal(t, "GraphQL/subscription/OnUser",
+		generateOperationName(&ast.OperationDefinition{Type: "SUBSCRIPTION", Name: ast.Ident{Name: "OnUser"}}, ""))
+}
@@ -18,8 +18,6 @@
 package grpc
 
 import (
-	"strings"
-
 	"github.com/apache/skywalking-go/plugins/core/operator"
 	"github.com/apache/skywalking-go/plugins/core/tracing"
 )
@@ -30,7 +28,7 @@ type ClientCloseSendInterceptor struct {
 func (h *ClientCloseSendInterceptor) BeforeInvoke(invocation operator.Invocation) error {
 	cs := invocation.CallerInstance().(*nativeclientStream)
 	method := cs.callHdr.Method
-	if strings.HasPrefix(method, skywalkingService) {
+	if ignoreClientMethod(method) {
 		return nil
 	}
 	s, err := tracing.CreateLocalSpan(formatOperationName(method, "/Client/Response/CloseSend"),
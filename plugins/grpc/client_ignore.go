@@ -0,0 +1,34 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// ignoreClientMethod reports whether the client call should not be traced,
+// either because it is the agent's own reporting or because a plugin for a
+// higher-level client (e.g. etcd) already traces the call.
+func ignoreClientMethod(method string) bool {
+	if strings.HasPrefix(method, skywalkingService) {
+		return true
+	}
+	return tracing.GetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey) == true
+}
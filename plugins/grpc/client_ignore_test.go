@@ -0,0 +1,45 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"testing"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+func TestIgnoreClientMethod(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	if !ignoreClientMethod("/skywalking.v3.TraceSegmentReportService/collect") {
+		t.Fatal("agent reporting calls should be ignored")
+	}
+	if ignoreClientMethod("/etcdserverpb.KV/Range") {
+		t.Fatal("calls should be traced by default")
+	}
+	tracing.SetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey, true)
+	if !ignoreClientMethod("/etcdserverpb.KV/Range") {
+		t.Fatal("calls should be ignored when the runtime context asks for it")
+	}
+	tracing.SetRuntimeContextValue(tracing.GRPCClientIgnoreRuntimeContextKey, nil)
+	if ignoreClientMethod("/etcdserverpb.KV/Range") {
+		t.Fatal("calls should be traced again once the flag is cleared")
+	}
+}
@@ -19,7 +19,6 @@ package grpc
 
 import (
 	"io"
-	"strings"
 
 	"github.com/apache/skywalking-go/plugins/core/operator"
 	"github.com/apache/skywalking-go/plugins/core/tracing"
@@ -31,7 +30,7 @@ type ClientRecvMsgInterceptor struct {
 func (h *ClientRecvMsgInterceptor) BeforeInvoke(invocation operator.Invocation) error {
 	cs := invocation.CallerInstance().(*nativeclientStream)
 	method := cs.callHdr.Method
-	if strings.HasPrefix(method, skywalkingService) {
+	if ignoreClientMethod(method) {
 		return nil
 	}
 	csEnhanced, ok := invocation.CallerInstance().(operator.EnhancedInstance)
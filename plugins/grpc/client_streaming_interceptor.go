@@ -19,7 +19,6 @@ package grpc
 
 import (
 	"context"
-	"strings"
 	"sync/atomic"
 
 	"google.golang.org/grpc/metadata"
@@ -51,7 +50,7 @@ func (h *ClientStreamingInterceptor) BeforeInvoke(invocation operator.Invocation
 	clientconn := invocation.CallerInstance().(*nativeClientConn)
 	ctx := invocation.Args()[0].(context.Context)
 	remoteAddr := clientconn.Target()
-	if strings.HasPrefix(method, skywalkingService) {
+	if ignoreClientMethod(method) {
 		return nil
 	}
 	s, err := tracing.CreateExitSpan(formatOperationName(method, ""), remoteAddr, func(headerKey, headerValue string) error {
@@ -19,7 +19,6 @@ package grpc
 
 import (
 	"context"
-	"strings"
 
 	"google.golang.org/grpc/metadata"
 
@@ -35,7 +34,7 @@ func (h *ClientUnaryInterceptor) BeforeInvoke(invocation operator.Invocation) er
 	method := invocation.Args()[1].(string)
 	clientconn := invocation.CallerInstance().(*nativeClientConn)
 	remoteAddr := clientconn.Target()
-	if strings.HasPrefix(method, skywalkingService) {
+	if ignoreClientMethod(method) {
 		return nil
 	}
 	s, err := tracing.CreateExitSpan(formatOperationName(method, ""), remoteAddr, func(headerKey, headerValue string) error {
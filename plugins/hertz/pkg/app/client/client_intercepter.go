@@ -0,0 +1,69 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/protocol"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type ClientInterceptor struct {
+}
+
+func (h *ClientInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	request := invocation.Args()[1].(*protocol.Request)
+	uri := request.URI()
+	if uri == nil {
+		return nil
+	}
+	method := string(request.Header.Method())
+	s, err := tracing.CreateExitSpan(fmt.Sprintf("%s:%s", method, string(uri.Path())),
+		string(uri.Host()), func(headerKey, headerValue string) error {
+			request.Header.Set(headerKey, headerValue)
+			return nil
+		}, tracing.WithLayer(tracing.SpanLayerHTTP),
+		tracing.WithTag(tracing.TagHTTPMethod, method),
+		tracing.WithTag(tracing.TagURL, uri.String()),
+		tracing.WithComponent(5005))
+	if err != nil {
+		return err
+	}
+	invocation.SetContext(s)
+	return nil
+}
+
+func (h *ClientInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	span := invocation.GetContext().(tracing.Span)
+	if err, ok := result[0].(error); ok && err != nil {
+		span.Error(err.Error())
+	} else if resp, ok := invocation.Args()[2].(*protocol.Response); ok && resp != nil {
+		span.Tag(tracing.TagStatusCode, fmt.Sprintf("%d", resp.StatusCode()))
+		if resp.StatusCode() >= 400 {
+			span.ErrorOccured()
+		}
+	}
+	span.End()
+	return nil
+}
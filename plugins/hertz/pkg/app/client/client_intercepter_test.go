@@ -0,0 +1,70 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/protocol"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestClientInvoke(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	req := protocol.AcquireRequest()
+	resp := protocol.AcquireResponse()
+	defer func() {
+		protocol.ReleaseRequest(req)
+		protocol.ReleaseResponse(resp)
+	}()
+
+	req.SetRequestURI("http://localhost:8080/user/1?name=test")
+	req.SetMethod("GET")
+	resp.SetStatusCode(400)
+
+	interceptor := &ClientInterceptor{}
+	invocation := operator.NewInvocation(nil, nil, req, resp)
+	err := interceptor.BeforeInvoke(invocation)
+	assert.Nil(t, err, "before invoke error should be nil")
+	assert.NotNil(t, invocation.GetContext(), "context should not be nil")
+	assert.NotEmpty(t, string(req.Header.Peek("sw8")), "sw8 header should be injected")
+
+	time.Sleep(100 * time.Millisecond)
+	err = interceptor.AfterInvoke(invocation, nil)
+	assert.Nil(t, err, "after invoke error should be nil")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GET:/user/1", spans[0].OperationName(), "operation name should be GET:/user/1")
+	assert.Equal(t, "localhost:8080", spans[0].Peer(), "peer should be localhost:8080")
+	assert.Equal(t, "Http", spans[0].SpanLayer().String(), "SpanLayer should be Http")
+	assert.Equal(t, int32(5005), spans[0].ComponentID(), "ComponentID should be 5005")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+	assert.Greater(t, spans[0].EndTime(), spans[0].StartTime(), "end time should be greater than start time")
+}
@@ -0,0 +1,82 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type ServerInterceptor struct {
+}
+
+func (h *ServerInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	if !isFirstHandle(invocation.CallerInstance()) {
+		return nil
+	}
+	ctx := invocation.CallerInstance().(*app.RequestContext)
+	method := string(ctx.Method())
+	// the route template is set by the engine before the handlers chain, it is empty when no route is matched
+	fullPath := ctx.FullPath()
+	if fullPath == "" {
+		fullPath = string(ctx.Path())
+	}
+	s, err := tracing.CreateEntrySpan(
+		fmt.Sprintf("%s:%s", method, fullPath), func(headerKey string) (string, error) {
+			return string(ctx.Request.Header.Peek(headerKey)), nil
+		},
+		tracing.WithLayer(tracing.SpanLayerHTTP),
+		tracing.WithTag(tracing.TagHTTPMethod, method),
+		tracing.WithTag(tracing.TagURL, string(ctx.Host())+string(ctx.Path())),
+		tracing.WithComponent(5004))
+	if err != nil {
+		return err
+	}
+	invocation.SetContext(s)
+	return nil
+}
+
+func (h *ServerInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	ctx := invocation.CallerInstance().(*app.RequestContext)
+	span := invocation.GetContext().(tracing.Span)
+	statusCode := ctx.Response.StatusCode()
+	span.Tag(tracing.TagStatusCode, fmt.Sprintf("%d", statusCode))
+	if statusCode >= 400 {
+		span.ErrorOccured()
+	}
+	if len(ctx.Errors) > 0 {
+		span.Error(ctx.Errors.String())
+	}
+	span.End()
+	return nil
+}
+
+func isFirstHandle(c interface{}) bool {
+	// index of handlers chain, incremented in #Next(), -1 indicates that no handler has been executed
+	if ctx, ok := c.(*nativeRequestContext); ok {
+		return ctx.index < 0
+	}
+	return true
+}
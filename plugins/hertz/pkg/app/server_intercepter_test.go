@@ -0,0 +1,96 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package app
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestServerInvoke(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	ctx := app.NewContext(0)
+	ctx.Request.SetMethod("GET")
+	ctx.Request.SetRequestURI("http://localhost/user/1")
+	ctx.SetFullPath("/user/:id")
+	ctx.Response.SetStatusCode(500)
+	_ = ctx.Error(errors.New("test error"))
+
+	interceptor := &ServerInterceptor{}
+	invocation := operator.NewInvocation(ctx, nil)
+	err := interceptor.BeforeInvoke(invocation)
+	assert.Nil(t, err, "before invoke error should be nil")
+	assert.NotNil(t, invocation.GetContext(), "context should not be nil")
+
+	time.Sleep(100 * time.Millisecond)
+	err = interceptor.AfterInvoke(invocation)
+	assert.Nil(t, err, "after invoke error should be nil")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GET:/user/:id", spans[0].OperationName(), "operation name should be the route template")
+	assert.Equal(t, "Http", spans[0].SpanLayer().String(), "SpanLayer should be Http")
+	assert.Equal(t, int32(5004), spans[0].ComponentID(), "ComponentID should be 5004")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+	assert.Equal(t, "500", tagValue(spans[0], tracing.TagStatusCode), "status code should be tagged")
+	assert.Greater(t, spans[0].EndTime(), spans[0].StartTime(), "end time should be greater than start time")
+}
+
+func TestServerInvokeWithoutRoute(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	ctx := app.NewContext(0)
+	ctx.Request.SetMethod("POST")
+	ctx.Request.SetRequestURI("http://localhost/not-found")
+	ctx.Response.SetStatusCode(404)
+
+	interceptor := &ServerInterceptor{}
+	invocation := operator.NewInvocation(ctx, nil)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, interceptor.AfterInvoke(invocation))
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "POST:/not-found", spans[0].OperationName(), "operation name should fall back to the request path")
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
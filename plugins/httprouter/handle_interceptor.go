@@ -0,0 +1,70 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package httprouter
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type HandleInterceptor struct {
+}
+
+// BeforeInvoke wraps the handle when the route is registered,
+// because the matched route path is not kept by the router.
+func (h *HandleInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	handle, ok := invocation.Args()[2].(httprouter.Handle)
+	if !ok || handle == nil {
+		return nil
+	}
+	method := invocation.Args()[0].(string)
+	routePath := invocation.Args()[1].(string)
+	invocation.ChangeArg(2, routeAwareHandle(method, routePath, handle))
+	return nil
+}
+
+func (h *HandleInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+func routeAwareHandle(method, routePath string, handle httprouter.Handle) httprouter.Handle {
+	routeName := method + ":" + routePath
+	return func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+		// re-set the operation name of the entry span created by the router or the net/http plugin
+		if span := tracing.ActiveSpan(); span != nil {
+			span.SetOperationName(routeName)
+			if config.CollectRouteParams && len(ps) > 0 {
+				span.Tag(tracing.TagHTTPRouteParams, formatRouteParams(ps))
+			}
+		}
+		handle(w, r, ps)
+	}
+}
+
+func formatRouteParams(ps httprouter.Params) string {
+	params := make([]string, 0, len(ps))
+	for _, param := range ps {
+		params = append(params, param.Key+"="+param.Value)
+	}
+	return strings.Join(params, "&")
+}
@@ -0,0 +1,81 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package httprouter
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestHandleRenameEntrySpan(t *testing.T) {
+	defer core.ResetTracingContext()
+	config.CollectRouteParams = true
+	defer func() {
+		config.CollectRouteParams = false
+	}()
+
+	var called bool
+	interceptor := &HandleInterceptor{}
+	invocation := operator.NewInvocation(nil, http.MethodGet, "/users/:id/*path",
+		httprouter.Handle(func(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+			called = true
+		}))
+	err := interceptor.BeforeInvoke(invocation)
+	assert.Nil(t, err, "before invoke error should be nil")
+
+	router := httprouter.New()
+	router.Handle(http.MethodGet, "/users/:id/*path", invocation.Args()[2].(httprouter.Handle))
+
+	span, err := tracing.CreateEntrySpan("GET:/users/1/books", func(headerKey string) (string, error) {
+		return "", nil
+	})
+	assert.Nil(t, err, "create entry span error should be nil")
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "http://localhost/users/1/books", http.NoBody))
+	span.End()
+
+	time.Sleep(100 * time.Millisecond)
+	assert.True(t, called, "handle should be called")
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "GET:/users/:id/*path", spans[0].OperationName(), "operation name should be the registered path")
+	assert.Equal(t, "id=1&path=/books", tagValue(spans[0], tracing.TagHTTPRouteParams), "route params should be collected")
+}
+
+func tagValue(span reporter.ReportedSpan, key string) string {
+	for _, tag := range span.Tags() {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
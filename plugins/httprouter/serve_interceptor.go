@@ -0,0 +1,130 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package httprouter
+
+import (
+	"net/http"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type ServeHTTPInterceptor struct {
+}
+
+func (n *ServeHTTPInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	// the span is already created by the net/http plugin, it would be renamed by the route handle
+	if tracing.ActiveSpan() != nil {
+		return nil
+	}
+	request := invocation.Args()[1].(*http.Request)
+	s, err := tracing.CreateEntrySpan(request.Method+":"+request.URL.Path, func(headerKey string) (string, error) {
+		return request.Header.Get(headerKey), nil
+	}, tracing.WithLayer(tracing.SpanLayerHTTP),
+		tracing.WithComponent(5004),
+		tracing.WithTag(tracing.TagHTTPMethod, request.Method),
+		tracing.WithTag(tracing.TagURL, request.Host+request.URL.Path))
+	if err != nil {
+		return err
+	}
+
+	rw := newResponseWriter(invocation.Args()[0])
+	invocation.ChangeArg(0, rw)
+	invocation.SetContext(s)
+	return nil
+}
+
+func (n *ServeHTTPInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	span := invocation.GetContext().(tracing.Span)
+	statusCode := http.StatusOK
+	if wrapped, ok := invocation.Args()[0].(*writerWrapper); ok {
+		statusCode = wrapped.statusCode
+	}
+	if wrapped, ok := invocation.Args()[0].(*writerWrapperWithHijacker); ok {
+		statusCode = wrapped.writer.statusCode
+	}
+	span.Tag(tracing.TagStatusCode, formatStatusCode(statusCode))
+	if statusCode >= 400 {
+		span.ErrorOccured()
+	}
+	span.End()
+	return nil
+}
+
+// formatStatusCode formats the status code without "fmt" or "strconv", which are not imported by the router package
+func formatStatusCode(statusCode int) string {
+	if statusCode <= 0 {
+		return "0"
+	}
+	digits := make([]byte, 0, 3)
+	for ; statusCode > 0; statusCode /= 10 {
+		digits = append([]byte{byte('0' + statusCode%10)}, digits...)
+	}
+	return string(digits)
+}
+
+func newResponseWriter(val interface{}) http.ResponseWriter {
+	sourceWriter := val.(http.ResponseWriter)
+	wrapper := newWriterWrapper(sourceWriter)
+	if hijacker, ok := sourceWriter.(http.Hijacker); ok {
+		return &writerWrapperWithHijacker{
+			ResponseWriter: wrapper,
+			writer:         wrapper,
+			Hijacker:       hijacker,
+		}
+	}
+	return wrapper
+}
+
+func newWriterWrapper(writer http.ResponseWriter) *writerWrapper {
+	return &writerWrapper{
+		ResponseWriter: writer,
+		statusCode:     http.StatusOK,
+	}
+}
+
+type writerWrapper struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *writerWrapper) WriteHeader(statusCode int) {
+	// cache the status code
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Flush keeps the streaming responses working through the wrapper.
+func (w *writerWrapper) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+type writerWrapperWithHijacker struct {
+	http.ResponseWriter
+	writer *writerWrapper // status code cache
+	http.Hijacker
+}
+
+func (w *writerWrapperWithHijacker) Flush() {
+	w.writer.Flush()
+}
@@ -0,0 +1,41 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"github.com/cloudwego/kitex/client"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+type NewClientInterceptor struct {
+}
+
+func (n *NewClientInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	options := invocation.Args()[1].([]client.Option)
+	// the instance middleware runs after the service discovery, so the remote address is known when creating the span
+	enhanced := make([]client.Option, 0, len(options)+1)
+	enhanced = append(enhanced, options...)
+	enhanced = append(enhanced, client.WithInstanceMW(clientMiddleware))
+	invocation.ChangeArg(1, enhanced)
+	return nil
+}
+
+func (n *NewClientInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	return nil
+}
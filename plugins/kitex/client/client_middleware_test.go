@@ -0,0 +1,64 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+
+	"github.com/apache/skywalking-go/plugins/core"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestClientMiddleware(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 8888}
+	ri := rpcinfo.NewRPCInfo(rpcinfo.EmptyEndpointInfo(), rpcinfo.NewEndpointInfo("echo", "echo", addr, nil),
+		rpcinfo.NewInvocation("Echo", "echo", "api"), nil, nil)
+	ctx := rpcinfo.NewCtxWithRPCInfo(context.Background(), ri)
+
+	var propagated string
+	err := clientMiddleware(func(ctx context.Context, req, resp interface{}) error {
+		propagated, _ = metainfo.GetValue(ctx, core.Header)
+		return errors.New("test error")
+	})(ctx, nil, nil)
+	assert.NotNil(t, err, "error should be returned")
+	assert.NotEmpty(t, propagated, "tracing context should be propagated by the metainfo")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 1, len(spans), "spans length should be 1")
+	assert.Equal(t, "api.Echo.echo", spans[0].OperationName(), "operation name should be api.Echo.echo")
+	assert.Equal(t, "127.0.0.1:8888", spans[0].Peer(), "peer should be the remote address")
+	assert.Equal(t, "RPCFramework", spans[0].SpanLayer().String(), "SpanLayer should be RPCFramework")
+	assert.Equal(t, int32(23), spans[0].ComponentID(), "ComponentID should be 23")
+	assert.True(t, spans[0].IsError(), "span should be marked as error")
+}
@@ -0,0 +1,41 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"github.com/cloudwego/kitex/server"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+type NewServerInterceptor struct {
+}
+
+func (n *NewServerInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	options := invocation.Args()[0].([]server.Option)
+	// the tracing middleware is the outermost one, so the spans of the customized middlewares are included
+	enhanced := make([]server.Option, 0, len(options)+1)
+	enhanced = append(enhanced, server.WithMiddleware(serverMiddleware))
+	enhanced = append(enhanced, options...)
+	invocation.ChangeArg(0, enhanced)
+	return nil
+}
+
+func (n *NewServerInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	return nil
+}
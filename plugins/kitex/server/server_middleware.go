@@ -0,0 +1,69 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"context"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+
+	"github.com/cloudwego/kitex/pkg/endpoint"
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+
+	"github.com/apache/skywalking-go/plugins/core/log"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+func serverMiddleware(next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, req, resp interface{}) error {
+		ri := rpcinfo.GetRPCInfo(ctx)
+		if ri == nil || ri.Invocation() == nil {
+			return next(ctx, req, resp)
+		}
+		span, err := tracing.CreateEntrySpan(generateOperationName(ri.Invocation()), func(key string) (string, error) {
+			if value, ok := metainfo.GetValue(ctx, key); ok {
+				return value, nil
+			}
+			// the keys are converted to the CGI variable style when transmitted by the HTTP2 metadata
+			value, _ := metainfo.GetValue(ctx, metainfo.HTTPHeaderToCGIVariable(key))
+			return value, nil
+		}, tracing.WithComponent(23),
+			tracing.WithLayer(tracing.SpanLayerRPCFramework))
+		if err != nil {
+			log.Warnf("cannot create entry span: %v", err)
+			return next(ctx, req, resp)
+		}
+		defer span.End()
+
+		err = next(ctx, req, resp)
+		if err != nil {
+			span.Error(err.Error())
+		} else if bizErr := ri.Invocation().BizStatusErr(); bizErr != nil {
+			span.Error(bizErr.Error())
+		}
+		return err
+	}
+}
+
+func generateOperationName(invocation rpcinfo.Invocation) string {
+	service := invocation.ServiceName()
+	if pkg := invocation.PackageName(); pkg != "" {
+		service = pkg + "." + service
+	}
+	return service + "." + invocation.MethodName()
+}
@@ -0,0 +1,95 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bytedance/gopkg/cloud/metainfo"
+
+	"github.com/cloudwego/kitex/pkg/rpcinfo"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	core.ResetTracingContext()
+}
+
+func TestServerMiddleware(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	ctx := context.Background()
+	exitSpan, err := tracing.CreateExitSpan("api.Echo.echo", "127.0.0.1:8888", func(key, value string) error {
+		ctx = metainfo.WithValue(ctx, key, value)
+		return nil
+	})
+	assert.Nil(t, err, "create exit span error should be nil")
+	exitSpan.End()
+
+	ri := rpcinfo.NewRPCInfo(rpcinfo.EmptyEndpointInfo(), rpcinfo.EmptyEndpointInfo(),
+		rpcinfo.NewInvocation("Echo", "echo", "api"), nil, nil)
+	ctx = rpcinfo.NewCtxWithRPCInfo(ctx, ri)
+
+	err = serverMiddleware(func(ctx context.Context, req, resp interface{}) error {
+		return nil
+	})(ctx, nil, nil)
+	assert.Nil(t, err, "error should be nil")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 2, len(spans), "spans length should be 2")
+	entrySpan := spans[1]
+	assert.Equal(t, "api.Echo.echo", entrySpan.OperationName(), "operation name should be api.Echo.echo")
+	assert.Equal(t, "RPCFramework", entrySpan.SpanLayer().String(), "SpanLayer should be RPCFramework")
+	assert.Equal(t, int32(23), entrySpan.ComponentID(), "ComponentID should be 23")
+	assert.Equal(t, 1, len(entrySpan.Refs()), "entry span should reference the client span")
+	assert.Equal(t, spans[0].Context().GetTraceID(), entrySpan.Context().GetTraceID(), "trace id should be propagated")
+	assert.False(t, entrySpan.IsError(), "span should not be marked as error")
+}
+
+func TestServerMiddlewareWithHTTP2Metadata(t *testing.T) {
+	defer core.ResetTracingContext()
+
+	ctx := context.Background()
+	exitSpan, err := tracing.CreateExitSpan("api.Echo.echo", "127.0.0.1:8888", func(key, value string) error {
+		ctx = metainfo.WithValue(ctx, metainfo.HTTPHeaderToCGIVariable(key), value)
+		return nil
+	})
+	assert.Nil(t, err, "create exit span error should be nil")
+	exitSpan.End()
+
+	ri := rpcinfo.NewRPCInfo(rpcinfo.EmptyEndpointInfo(), rpcinfo.EmptyEndpointInfo(),
+		rpcinfo.NewInvocation("Echo", "echo", "api"), nil, nil)
+	ctx = rpcinfo.NewCtxWithRPCInfo(ctx, ri)
+
+	err = serverMiddleware(func(ctx context.Context, req, resp interface{}) error {
+		return nil
+	})(ctx, nil, nil)
+	assert.Nil(t, err, "error should be nil")
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	assert.Equal(t, 2, len(spans), "spans length should be 2")
+	assert.Equal(t, 1, len(spans[1].Refs()), "entry span should reference the client span")
+}
@@ -0,0 +1,214 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	natsConsumerSuffix = "/Consumer"
+	// the interval to check whether the channel subscription is still valid
+	chanSubscriptionCheckInterval = time.Second
+)
+
+// SubscribeInterceptor wraps the message handler of Subscribe and QueueSubscribe,
+// for both the connection and the JetStreamContext.
+type SubscribeInterceptor struct {
+}
+
+func (s *SubscribeInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	conn, prefix := subscriptionConn(invocation.CallerInstance())
+	if isInternalSubscription(conn, invocation.Args()[0]) {
+		return nil
+	}
+	for i, arg := range invocation.Args() {
+		if handler, ok := arg.(nats.MsgHandler); ok && handler != nil {
+			invocation.ChangeArg(i, nats.MsgHandler(func(msg *nats.Msg) {
+				// the handler is called in the goroutine of the subscription, should not be linked to the subscriber
+				tracing.CleanContext()
+				span := createConsumerSpan(conn, prefix, msg)
+				defer endConsumerSpan(span)
+				handler(msg)
+			}))
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *SubscribeInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+// ChanSubscribeInterceptor replaces the channel of ChanSubscribe and ChanQueueSubscribe,
+// the messages are traced when forwarding to the channel of user, and dropped as the slow consumer
+// when the channel of user is full.
+type ChanSubscribeInterceptor struct {
+}
+
+type chanSubscription struct {
+	source chan *nats.Msg
+	target chan *nats.Msg
+}
+
+func (c *ChanSubscribeInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	if conn, _ := subscriptionConn(invocation.CallerInstance()); isInternalSubscription(conn, invocation.Args()[0]) {
+		return nil
+	}
+	for i, arg := range invocation.Args() {
+		if ch, ok := arg.(chan *nats.Msg); ok && ch != nil {
+			source := make(chan *nats.Msg, cap(ch))
+			invocation.ChangeArg(i, source)
+			invocation.SetContext(&chanSubscription{source: source, target: ch})
+			return nil
+		}
+	}
+	return nil
+}
+
+func (c *ChanSubscribeInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	sub, ok := result[0].(*nats.Subscription)
+	if !ok || sub == nil {
+		return nil
+	}
+	conn, prefix := subscriptionConn(invocation.CallerInstance())
+	go forwardChanMessages(conn, prefix, sub, invocation.GetContext().(*chanSubscription))
+	return nil
+}
+
+func forwardChanMessages(conn *nats.Conn, prefix string, sub *nats.Subscription, ch *chanSubscription) {
+	defer func() {
+		// the channel could be closed by the user after unsubscribe
+		_ = recover()
+	}()
+	tracing.CleanContext()
+	ticker := time.NewTicker(chanSubscriptionCheckInterval)
+	defer ticker.Stop()
+	slowConsumer := false
+	for {
+		select {
+		case msg := <-ch.source:
+			span := createConsumerSpan(conn, prefix, msg)
+			// never block on the channel of user, otherwise the goroutine leaks when the user stops receiving,
+			// and the messages buffered in the source channel would hide the slow consumer
+			select {
+			case ch.target <- msg:
+				slowConsumer = false
+			default:
+				if span != nil {
+					span.Error(nats.ErrSlowConsumer.Error())
+				}
+				// drop the message as the client does, and only notify once until the consumer catches up
+				if !slowConsumer {
+					slowConsumer = true
+					notifySlowConsumer(conn, sub)
+				}
+			}
+			endConsumerSpan(span)
+		case <-ticker.C:
+			if !sub.IsValid() && len(ch.source) == 0 {
+				return
+			}
+		}
+	}
+}
+
+func notifySlowConsumer(conn *nats.Conn, sub *nats.Subscription) {
+	if conn == nil || conn.Opts.AsyncErrorCB == nil {
+		return
+	}
+	go conn.Opts.AsyncErrorCB(conn, sub, nats.ErrSlowConsumer)
+}
+
+// FetchInterceptor traces the messages fetched by the pull subscription of JetStreamContext.
+type FetchInterceptor struct {
+}
+
+func (f *FetchInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (f *FetchInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	msgs, ok := result[0].([]*nats.Msg)
+	if !ok || len(msgs) == 0 {
+		return nil
+	}
+	sub := invocation.CallerInstance().(*nativeSubscription)
+	for _, msg := range msgs {
+		endConsumerSpan(createConsumerSpan(sub.conn, natsJetStreamPrefix, msg))
+	}
+	return nil
+}
+
+func subscriptionConn(caller interface{}) (conn *nats.Conn, prefix string) {
+	if js, ok := caller.(*nativeJS); ok && js != nil {
+		return js.nc, natsJetStreamPrefix
+	}
+	conn, _ = caller.(*nats.Conn)
+	return conn, natsPrefix
+}
+
+// isInternalSubscription checks the subscription is used by the client itself, such as the inbox of
+// the JetStream pull consumer, the messages are traced by the JetStream plugin instead.
+func isInternalSubscription(conn *nats.Conn, subjectArg interface{}) bool {
+	subject, _ := subjectArg.(string)
+	return isInternalSubject(subject) || strings.HasPrefix(subject, inboxPrefix(conn))
+}
+
+func createConsumerSpan(conn *nats.Conn, prefix string, msg *nats.Msg) tracing.Span {
+	if msg == nil {
+		return nil
+	}
+	peer := connAddress(conn)
+	opts := []tracing.SpanOption{
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(natsComponentID),
+		tracing.WithTag(tracing.TagMQBroker, peer),
+		tracing.WithTag(tracing.TagMQTopic, msg.Subject),
+	}
+	if msg.Sub != nil && msg.Sub.Queue != "" {
+		opts = append(opts, tracing.WithTag(tracing.TagMQQueue, msg.Sub.Queue))
+	}
+	span, err := tracing.CreateEntrySpan(prefix+msg.Subject+natsConsumerSuffix, func(headerKey string) (string, error) {
+		if values := msg.Header[headerKey]; len(values) > 0 {
+			return values[0], nil
+		}
+		return "", nil
+	}, opts...)
+	if err != nil {
+		return nil
+	}
+	span.SetPeer(peer)
+	return span
+}
+
+func endConsumerSpan(span tracing.Span) {
+	if span != nil {
+		span.End()
+	}
+}
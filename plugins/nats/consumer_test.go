@@ -0,0 +1,77 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/apache/skywalking-go/plugins/core"
+)
+
+func TestForwardChanMessagesToFullChannel(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	slowConsumer := make(chan error, 2)
+	conn := &nats.Conn{Opts: nats.Options{AsyncErrorCB: func(_ *nats.Conn, _ *nats.Subscription, err error) {
+		slowConsumer <- err
+	}}}
+	ch := &chanSubscription{source: make(chan *nats.Msg, 3), target: make(chan *nats.Msg, 1)}
+	for i := 0; i < 3; i++ {
+		ch.source <- &nats.Msg{Subject: "orders.created"}
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// the subscription is not valid, the forwarding stops once the source channel is drained
+		forwardChanMessages(conn, natsPrefix, &nats.Subscription{}, ch)
+	}()
+	select {
+	case <-done:
+	case <-time.After(3 * chanSubscriptionCheckInterval):
+		t.Fatal("the forwarding should not be blocked by the full channel of user")
+	}
+
+	if len(ch.target) != 1 {
+		t.Errorf("expected 1 message delivered, actual %d", len(ch.target))
+	}
+	select {
+	case err := <-slowConsumer:
+		if err != nats.ErrSlowConsumer {
+			t.Errorf("unexpected error %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the slow consumer should be notified")
+	}
+	if len(slowConsumer) != 0 {
+		t.Errorf("the slow consumer should only be notified once")
+	}
+	errorSpans := 0
+	spans := core.GetReportedSpans()
+	for _, s := range spans {
+		if s.IsError() {
+			errorSpans++
+		}
+	}
+	if len(spans) != 3 || errorSpans != 2 {
+		t.Errorf("expected 3 spans and 2 of them are error, actual %d spans, %d error spans", len(spans), errorSpans)
+	}
+}
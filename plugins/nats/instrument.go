@@ -0,0 +1,273 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"embed"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "nats"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "github.com/nats-io/nats.go"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return true
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		// publish, the message without header is published through PublishMsg for propagating the context
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "Publish",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "string"),
+				instrument.WithArgType(1, "[]byte"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error"),
+			),
+			Interceptor: "PublishInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "PublishRequest",
+				instrument.WithArgsCount(3),
+				instrument.WithArgType(0, "string"),
+				instrument.WithArgType(1, "string"),
+				instrument.WithArgType(2, "[]byte"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error"),
+			),
+			Interceptor: "PublishRequestInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "PublishMsg",
+				instrument.WithArgsCount(1),
+				instrument.WithArgType(0, "*Msg"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error"),
+			),
+			Interceptor: "PublishMsgInterceptor",
+		},
+		// request
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "Request",
+				instrument.WithArgsCount(3),
+				instrument.WithArgType(0, "string"),
+				instrument.WithArgType(1, "[]byte"),
+				instrument.WithArgType(2, "time.Duration"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "*Msg"),
+				instrument.WithResultType(1, "error"),
+			),
+			Interceptor: "RequestInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "RequestWithContext",
+				instrument.WithArgsCount(3),
+				instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(1, "string"),
+				instrument.WithArgType(2, "[]byte"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "*Msg"),
+				instrument.WithResultType(1, "error"),
+			),
+			Interceptor: "RequestWithContextInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "RequestMsg",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "*Msg"),
+				instrument.WithArgType(1, "time.Duration"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "*Msg"),
+				instrument.WithResultType(1, "error"),
+			),
+			Interceptor: "RequestMsgInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "RequestMsgWithContext",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "context.Context"),
+				instrument.WithArgType(1, "*Msg"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "*Msg"),
+				instrument.WithResultType(1, "error"),
+			),
+			Interceptor: "RequestMsgInterceptor",
+		},
+		// subscribe
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "Subscribe",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "string"),
+				instrument.WithArgType(1, "MsgHandler"),
+			),
+			Interceptor: "SubscribeInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "QueueSubscribe",
+				instrument.WithArgsCount(3),
+				instrument.WithArgType(0, "string"),
+				instrument.WithArgType(1, "string"),
+				instrument.WithArgType(2, "MsgHandler"),
+			),
+			Interceptor: "SubscribeInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "ChanSubscribe",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "string"),
+				instrument.WithArgType(1, "chan *Msg"),
+			),
+			Interceptor: "ChanSubscribeInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Conn", "ChanQueueSubscribe",
+				instrument.WithArgsCount(3),
+				instrument.WithArgType(0, "string"),
+				instrument.WithArgType(1, "string"),
+				instrument.WithArgType(2, "chan *Msg"),
+			),
+			Interceptor: "ChanSubscribeInterceptor",
+		},
+		// JetStreamContext
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*js", "PublishMsg",
+				instrument.WithArgType(0, "*Msg"),
+				instrument.WithResultCount(2),
+			),
+			Interceptor: "JetStreamPublishInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*js", "PublishMsgAsync",
+				instrument.WithArgType(0, "*Msg"),
+				instrument.WithResultCount(2),
+			),
+			Interceptor: "JetStreamPublishInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At:          instrument.NewMethodEnhance("*js", "Subscribe", instrument.WithArgType(1, "MsgHandler")),
+			Interceptor: "SubscribeInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At:          instrument.NewMethodEnhance("*js", "QueueSubscribe", instrument.WithArgType(2, "MsgHandler")),
+			Interceptor: "SubscribeInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At:          instrument.NewMethodEnhance("*js", "ChanSubscribe", instrument.WithArgType(1, "chan *Msg")),
+			Interceptor: "ChanSubscribeInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At:          instrument.NewMethodEnhance("*js", "ChanQueueSubscribe", instrument.WithArgType(2, "chan *Msg")),
+			Interceptor: "ChanSubscribeInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "nats",
+			At: instrument.NewMethodEnhance("*Subscription", "Fetch",
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "[]*Msg"),
+			),
+			Interceptor: "FetchInterceptor",
+		},
+		// JetStream
+		{
+			PackagePath: "jetstream",
+			At: instrument.NewMethodEnhance("*jetStream", "PublishMsg",
+				instrument.WithArgType(1, "*nats.Msg"),
+				instrument.WithResultCount(2),
+			),
+			Interceptor: "PublishInterceptor",
+		},
+		{
+			PackagePath: "jetstream",
+			At: instrument.NewMethodEnhance("*jetStream", "PublishMsgAsync",
+				instrument.WithArgType(0, "*nats.Msg"),
+				instrument.WithResultCount(2),
+			),
+			Interceptor: "PublishInterceptor",
+		},
+		{
+			PackagePath: "jetstream",
+			At:          instrument.NewMethodEnhance("*pullConsumer", "Consume", instrument.WithArgType(0, "MessageHandler")),
+			Interceptor: "ConsumeInterceptor",
+		},
+		{
+			PackagePath: "jetstream",
+			At: instrument.NewMethodEnhance("*pullSubscription", "Next",
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "Msg"),
+			),
+			Interceptor: "NextInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
@@ -0,0 +1,96 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jetstream
+
+import (
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// ConsumeInterceptor wraps the message handler of the pull consumer, the ordered consumer is delegated to it.
+type ConsumeInterceptor struct {
+}
+
+func (c *ConsumeInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	handler, ok := invocation.Args()[0].(jetstream.MessageHandler)
+	if !ok || handler == nil {
+		return nil
+	}
+	invocation.ChangeArg(0, jetstream.MessageHandler(func(msg jetstream.Msg) {
+		// the handler is called in the goroutine of the consumer, should not be linked to the subscriber
+		tracing.CleanContext()
+		span := createConsumerSpan(msg)
+		defer endConsumerSpan(span)
+		handler(msg)
+	}))
+	return nil
+}
+
+func (c *ConsumeInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+// NextInterceptor traces the message received from the iterator of the Messages.
+type NextInterceptor struct {
+}
+
+func (n *NextInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *NextInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if msg, ok := result[0].(jetstream.Msg); ok && msg != nil {
+		endConsumerSpan(createConsumerSpan(msg))
+	}
+	return nil
+}
+
+func createConsumerSpan(msg jetstream.Msg) tracing.Span {
+	if msg == nil {
+		return nil
+	}
+	var peer string
+	if m, ok := interface{}(msg).(*nativeJetStreamMsg); ok && m.js != nil {
+		peer = connAddress(m.js.conn)
+	}
+	headers := msg.Headers()
+	span, err := tracing.CreateEntrySpan(natsJetStreamPrefix+msg.Subject()+natsConsumerSuffix, func(headerKey string) (string, error) {
+		if values := headers[headerKey]; len(values) > 0 {
+			return values[0], nil
+		}
+		return "", nil
+	},
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(natsComponentID),
+		tracing.WithTag(tracing.TagMQBroker, peer),
+		tracing.WithTag(tracing.TagMQTopic, msg.Subject()),
+	)
+	if err != nil {
+		return nil
+	}
+	span.SetPeer(peer)
+	return span
+}
+
+func endConsumerSpan(span tracing.Span) {
+	if span != nil {
+		span.End()
+	}
+}
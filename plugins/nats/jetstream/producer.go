@@ -0,0 +1,104 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jetstream
+
+import (
+	"net/url"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	natsJetStreamPrefix = "Nats/JetStream/"
+	natsProducerSuffix  = "/Producer"
+	natsConsumerSuffix  = "/Consumer"
+	natsComponentID     = 132
+	tagMQStream         = "mq.stream"
+)
+
+// PublishInterceptor traces the PublishMsg and PublishMsgAsync of JetStream,
+// the Publish and PublishAsync are delegated to them.
+type PublishInterceptor struct {
+}
+
+func (p *PublishInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	var msg *nats.Msg
+	for _, arg := range invocation.Args() {
+		if m, ok := arg.(*nats.Msg); ok && m != nil {
+			msg = m
+			break
+		}
+	}
+	if msg == nil {
+		return nil
+	}
+	conn := invocation.CallerInstance().(*nativeJetStream).conn
+	peer := connAddress(conn)
+	injectable := conn != nil && conn.HeadersSupported()
+	span, err := tracing.CreateExitSpan(natsJetStreamPrefix+msg.Subject+natsProducerSuffix, peer, func(headerKey, headerValue string) error {
+		if !injectable {
+			return nil
+		}
+		if msg.Header == nil {
+			msg.Header = nats.Header{}
+		}
+		msg.Header[headerKey] = []string{headerValue}
+		return nil
+	},
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(natsComponentID),
+		tracing.WithTag(tracing.TagMQBroker, peer),
+		tracing.WithTag(tracing.TagMQTopic, msg.Subject),
+	)
+	if err != nil {
+		return err
+	}
+	invocation.SetContext(span)
+	return nil
+}
+
+func (p *PublishInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	span := invocation.GetContext().(tracing.Span)
+	if ack, ok := result[0].(*jetstream.PubAck); ok && ack != nil {
+		span.Tag(tagMQStream, ack.Stream)
+	}
+	if err, ok := result[1].(error); ok && err != nil {
+		span.Tag(tracing.TagMQStatus, err.Error())
+		span.Error(err.Error())
+	}
+	span.End()
+	return nil
+}
+
+// connAddress prefers the host of the connected server url, which is same with the configured address.
+func connAddress(conn *nats.Conn) string {
+	if conn == nil {
+		return ""
+	}
+	if u, err := url.Parse(conn.ConnectedUrl()); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return conn.ConnectedAddr()
+}
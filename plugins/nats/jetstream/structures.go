@@ -0,0 +1,33 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jetstream
+
+import (
+	"github.com/nats-io/nats.go"
+)
+
+//skywalking:native github.com/nats-io/nats.go/jetstream jetStream
+type nativeJetStream struct {
+	conn *nats.Conn
+}
+
+//skywalking:native github.com/nats-io/nats.go/jetstream jetStreamMsg
+type nativeJetStreamMsg struct {
+	msg *nats.Msg
+	js  *nativeJetStream
+}
@@ -0,0 +1,332 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	natsPrefix          = "Nats/"
+	natsJetStreamPrefix = "Nats/JetStream/"
+	natsProducerSuffix  = "/Producer"
+	natsRequestSuffix   = "/Request"
+	natsComponentID     = 132
+	tagMQStream         = "mq.stream"
+	// the subjects of the JetStream API, acknowledgement and flow control
+	jetStreamInternalPrefix = "$JS."
+	// same with the nats.InboxPrefix
+	defaultInboxPrefix = "_INBOX."
+)
+
+// PublishInterceptor sends the message through PublishMsg when the server supports headers,
+// then the tracing context could be propagated in the message header.
+type PublishInterceptor struct {
+}
+
+func (p *PublishInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	conn := invocation.CallerInstance().(*nats.Conn)
+	subject := invocation.Args()[0].(string)
+	if isInternalSubject(subject) {
+		return nil
+	}
+	if conn.HeadersSupported() {
+		msg := &nats.Msg{Subject: subject, Data: invocation.Args()[1].([]byte)}
+		invocation.DefineReturnValues(conn.PublishMsg(msg))
+		return nil
+	}
+	span, err := createProducerSpan(connAddress(conn), natsPrefix+operationSubject(conn, subject)+natsProducerSuffix, subject, nil)
+	if err != nil {
+		return err
+	}
+	if span != nil {
+		invocation.SetContext(span)
+	}
+	return nil
+}
+
+func (p *PublishInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	finishProducerSpan(invocation, result[0])
+	return nil
+}
+
+// PublishRequestInterceptor sends the message through PublishMsg with the reply subject.
+type PublishRequestInterceptor struct {
+}
+
+func (p *PublishRequestInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	conn := invocation.CallerInstance().(*nats.Conn)
+	subject := invocation.Args()[0].(string)
+	if isInternalSubject(subject) {
+		return nil
+	}
+	if conn.HeadersSupported() {
+		msg := &nats.Msg{Subject: subject, Reply: invocation.Args()[1].(string), Data: invocation.Args()[2].([]byte)}
+		invocation.DefineReturnValues(conn.PublishMsg(msg))
+		return nil
+	}
+	span, err := createProducerSpan(connAddress(conn), natsPrefix+operationSubject(conn, subject)+natsProducerSuffix, subject, nil)
+	if err != nil {
+		return err
+	}
+	if span != nil {
+		invocation.SetContext(span)
+	}
+	return nil
+}
+
+func (p *PublishRequestInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	finishProducerSpan(invocation, result[0])
+	return nil
+}
+
+// PublishMsgInterceptor traces the PublishMsg, the Publish, PublishRequest and Msg.Respond are delegated to it.
+type PublishMsgInterceptor struct {
+}
+
+func (p *PublishMsgInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	msg, ok := invocation.Args()[0].(*nats.Msg)
+	if !ok || msg == nil {
+		return nil
+	}
+	conn := invocation.CallerInstance().(*nats.Conn)
+	operationName := natsPrefix + operationSubject(conn, msg.Subject) + natsProducerSuffix
+	span, err := createProducerSpan(connAddress(conn), operationName, msg.Subject, headerCarrier(conn, msg))
+	if err != nil {
+		return err
+	}
+	if span != nil {
+		invocation.SetContext(span)
+	}
+	return nil
+}
+
+func (p *PublishMsgInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	finishProducerSpan(invocation, result[0])
+	return nil
+}
+
+// RequestInterceptor sends the request through RequestMsg when the server supports headers.
+type RequestInterceptor struct {
+}
+
+func (r *RequestInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	conn := invocation.CallerInstance().(*nats.Conn)
+	subject := invocation.Args()[0].(string)
+	if isInternalSubject(subject) {
+		return nil
+	}
+	if conn.HeadersSupported() {
+		msg := &nats.Msg{Subject: subject, Data: invocation.Args()[1].([]byte)}
+		invocation.DefineReturnValues(conn.RequestMsg(msg, invocation.Args()[2].(time.Duration)))
+		return nil
+	}
+	span, err := createProducerSpan(connAddress(conn), natsPrefix+operationSubject(conn, subject)+natsRequestSuffix, subject, nil)
+	if err != nil {
+		return err
+	}
+	if span != nil {
+		invocation.SetContext(span)
+	}
+	return nil
+}
+
+func (r *RequestInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	finishProducerSpan(invocation, result[1])
+	return nil
+}
+
+// RequestWithContextInterceptor sends the request through RequestMsgWithContext when the server supports headers.
+type RequestWithContextInterceptor struct {
+}
+
+func (r *RequestWithContextInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	conn := invocation.CallerInstance().(*nats.Conn)
+	subject := invocation.Args()[1].(string)
+	if isInternalSubject(subject) {
+		return nil
+	}
+	if conn.HeadersSupported() {
+		msg := &nats.Msg{Subject: subject, Data: invocation.Args()[2].([]byte)}
+		invocation.DefineReturnValues(conn.RequestMsgWithContext(invocation.Args()[0].(context.Context), msg))
+		return nil
+	}
+	span, err := createProducerSpan(connAddress(conn), natsPrefix+operationSubject(conn, subject)+natsRequestSuffix, subject, nil)
+	if err != nil {
+		return err
+	}
+	if span != nil {
+		invocation.SetContext(span)
+	}
+	return nil
+}
+
+func (r *RequestWithContextInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	finishProducerSpan(invocation, result[1])
+	return nil
+}
+
+// RequestMsgInterceptor traces the RequestMsg and RequestMsgWithContext, the span is finished when the reply is received.
+type RequestMsgInterceptor struct {
+}
+
+func (r *RequestMsgInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	msg := findMsgArg(invocation.Args())
+	if msg == nil {
+		return nil
+	}
+	conn := invocation.CallerInstance().(*nats.Conn)
+	operationName := natsPrefix + operationSubject(conn, msg.Subject) + natsRequestSuffix
+	span, err := createProducerSpan(connAddress(conn), operationName, msg.Subject, headerCarrier(conn, msg))
+	if err != nil {
+		return err
+	}
+	if span != nil {
+		invocation.SetContext(span)
+	}
+	return nil
+}
+
+func (r *RequestMsgInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	finishProducerSpan(invocation, result[1])
+	return nil
+}
+
+// JetStreamPublishInterceptor traces the PublishMsg and PublishMsgAsync of the JetStreamContext,
+// the Publish and PublishAsync are delegated to them.
+type JetStreamPublishInterceptor struct {
+}
+
+func (j *JetStreamPublishInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	msg := findMsgArg(invocation.Args())
+	if msg == nil {
+		return nil
+	}
+	js := invocation.CallerInstance().(*nativeJS)
+	span, err := createProducerSpan(connAddress(js.nc), natsJetStreamPrefix+msg.Subject+natsProducerSuffix, msg.Subject, headerCarrier(js.nc, msg))
+	if err != nil {
+		return err
+	}
+	if span != nil {
+		invocation.SetContext(span)
+	}
+	return nil
+}
+
+func (j *JetStreamPublishInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	if ack, ok := result[0].(*nats.PubAck); ok && ack != nil {
+		invocation.GetContext().(tracing.Span).Tag(tagMQStream, ack.Stream)
+	}
+	finishProducerSpan(invocation, result[1])
+	return nil
+}
+
+// createProducerSpan creates the exit span, the tracing context is injected into the header of the message
+// when the message is not nil. The span is not created for the internal subjects of JetStream.
+func createProducerSpan(peer, operationName, subject string, msg *nats.Msg) (tracing.Span, error) {
+	if isInternalSubject(subject) {
+		return nil, nil
+	}
+	return tracing.CreateExitSpan(operationName, peer, func(headerKey, headerValue string) error {
+		if msg == nil {
+			return nil
+		}
+		if msg.Header == nil {
+			msg.Header = nats.Header{}
+		}
+		msg.Header[headerKey] = []string{headerValue}
+		return nil
+	},
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(natsComponentID),
+		tracing.WithTag(tracing.TagMQBroker, peer),
+		tracing.WithTag(tracing.TagMQTopic, subject),
+	)
+}
+
+// headerCarrier returns the message only when the server supports headers,
+// otherwise the message would be rejected by the client.
+func headerCarrier(conn *nats.Conn, msg *nats.Msg) *nats.Msg {
+	if conn == nil || !conn.HeadersSupported() {
+		return nil
+	}
+	return msg
+}
+
+func finishProducerSpan(invocation operator.Invocation, errResult interface{}) {
+	if invocation.GetContext() == nil {
+		return
+	}
+	span := invocation.GetContext().(tracing.Span)
+	if err, ok := errResult.(error); ok && err != nil {
+		span.Tag(tracing.TagMQStatus, err.Error())
+		span.Error(err.Error())
+	}
+	span.End()
+}
+
+func findMsgArg(args []interface{}) *nats.Msg {
+	for _, arg := range args {
+		if msg, ok := arg.(*nats.Msg); ok && msg != nil {
+			return msg
+		}
+	}
+	return nil
+}
+
+// connAddress prefers the host of the connected server url, which is same with the configured address.
+func connAddress(conn *nats.Conn) string {
+	if conn == nil {
+		return ""
+	}
+	if u, err := url.Parse(conn.ConnectedUrl()); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return conn.ConnectedAddr()
+}
+
+func isInternalSubject(subject string) bool {
+	return strings.HasPrefix(subject, jetStreamInternalPrefix)
+}
+
+// operationSubject uses the inbox prefix instead of the random inbox subject, such as the reply subject.
+func operationSubject(conn *nats.Conn, subject string) string {
+	prefix := inboxPrefix(conn)
+	if strings.HasPrefix(subject, prefix) {
+		return strings.TrimSuffix(prefix, ".")
+	}
+	return subject
+}
+
+func inboxPrefix(conn *nats.Conn) string {
+	if conn != nil && conn.Opts.InboxPrefix != "" {
+		return conn.Opts.InboxPrefix + "."
+	}
+	return defaultInboxPrefix
+}
@@ -0,0 +1,131 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+)
+
+func findReportedSpan(t *testing.T, name string) reporter.ReportedSpan {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		for _, s := range core.GetReportedSpans() {
+			if s.OperationName() == name {
+				return s
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("span %q was never reported", name)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestOperationSubject(t *testing.T) {
+	tests := []struct {
+		conn     *nats.Conn
+		subject  string
+		expected string
+	}{
+		{nil, "orders.created", "orders.created"},
+		{nil, "_INBOX.abc.1", "_INBOX"},
+		{&nats.Conn{Opts: nats.Options{InboxPrefix: "_REPLY"}}, "_REPLY.abc.1", "_REPLY"},
+		{&nats.Conn{Opts: nats.Options{InboxPrefix: "_REPLY"}}, "_INBOX.abc.1", "_INBOX.abc.1"},
+	}
+	for _, tt := range tests {
+		if actual := operationSubject(tt.conn, tt.subject); actual != tt.expected {
+			t.Errorf("subject %s: expected %s, actual %s", tt.subject, tt.expected, actual)
+		}
+	}
+}
+
+func TestInternalSubscription(t *testing.T) {
+	if !isInternalSubscription(nil, "_INBOX.abc.*") {
+		t.Error("the inbox subscription should be ignored")
+	}
+	if !isInternalSubscription(nil, "$JS.ACK.stream.>") {
+		t.Error("the JetStream subscription should be ignored")
+	}
+	if isInternalSubscription(nil, "orders.*") {
+		t.Error("the user subscription should be traced")
+	}
+}
+
+func TestPublishMsgPropagation(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	msg := &nats.Msg{Subject: "orders.created", Reply: "_INBOX.abc.1"}
+	span, err := createProducerSpan("nats:4222", "Nats/orders.created/Request", msg.Subject, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	span.End()
+	if len(msg.Header.Get("sw8")) == 0 {
+		t.Fatal("the tracing context should be injected into the message header")
+	}
+
+	// the handler is called by the subscription goroutine
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		msg.Sub = &nats.Subscription{Queue: "workers"}
+		endConsumerSpan(createConsumerSpan(nil, natsPrefix, msg))
+	}()
+	<-done
+
+	producer := findReportedSpan(t, "Nats/orders.created/Request")
+	consumer := findReportedSpan(t, "Nats/orders.created/Consumer")
+	if len(consumer.Refs()) != 1 {
+		t.Fatalf("expected 1 ref of the consumer span, actual %d", len(consumer.Refs()))
+	}
+	if ref := consumer.Refs()[0]; ref.GetTraceID() != producer.Context().GetTraceID() ||
+		ref.GetParentSegmentID() != producer.Context().GetSegmentID() {
+		t.Errorf("the consumer span is not linked to the producer span")
+	}
+	var queue string
+	for _, tag := range consumer.Tags() {
+		if tag.Key == "mq.queue" {
+			queue = tag.Value
+		}
+	}
+	if queue != "workers" {
+		t.Errorf("expected the queue tag workers, actual %s", queue)
+	}
+}
+
+func TestPublishInternalSubject(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	msg := &nats.Msg{Subject: "$JS.ACK.stream.consumer.1.1.1"}
+	span, err := createProducerSpan("nats:4222", "Nats/ack/Producer", msg.Subject, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if span != nil || msg.Header != nil {
+		t.Error("the acknowledgement of JetStream should not be traced")
+	}
+}
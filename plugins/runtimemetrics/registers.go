@@ -18,7 +18,6 @@
 package runtimemetrics
 
 import (
-	"math"
 	original_metrics "runtime/metrics"
 
 	"github.com/apache/skywalking-go/plugins/core/metrics"
@@ -71,42 +70,83 @@ var nameReplacing = map[string]*meterInfo{
 	"/memory/classes/profiling/buckets:bytes": newMetricsGaugeReplaceInfo("instance_golang_memory_profiling_buckets"),
 	"/memory/classes/total:bytes":             newMetricsGaugeReplaceInfo("instance_golang_memory_total"),
 
+	// Mutex, goroutine creation and GC tuning
+	"/sync/mutex/wait/total:seconds":       newMetricsGaugeReplaceInfo("instance_golang_mutex_wait_total"),
+	"/sched/goroutines-created:goroutines": newMetricsGaugeReplaceInfo("instance_golang_goroutines_created"),
+	"/gc/gomemlimit:bytes":                 newMetricsGaugeReplaceInfo("instance_golang_gc_memory_limit"),
+	"/gc/gogc:percent":                     newMetricsGaugeReplaceInfo("instance_golang_gc_gogc_percent"),
+
 	// Histogram
-	"/gc/heap/allocs-by-size:bytes": newMetricsHistogramReplaceInfo("instance_golang_gc_heap_allocs_by_size", 1),
-	"/gc/heap/frees-by-size:bytes":  newMetricsHistogramReplaceInfo("instance_golang_gc_heap_frees_by_size", 1),
-	"/gc/pauses:seconds":            newMetricsHistogramReplaceInfo("instance_golang_gc_pauses", 1000_000_000),
-	"/sched/latencies:seconds":      newMetricsHistogramReplaceInfo("instance_golang_sched_latencies", 1000_000_000),
+	"/gc/heap/allocs-by-size:bytes": newMetricsHistogramReplaceInfo("instance_golang_gc_heap_allocs_by_size", 1, sizeBuckets),
+	"/gc/heap/frees-by-size:bytes":  newMetricsHistogramReplaceInfo("instance_golang_gc_heap_frees_by_size", 1, sizeBuckets),
+	// the "/gc/pauses:seconds" is deprecated since go 1.22, prefer the new one when the runtime supported
+	"/gc/pauses:seconds": newMetricsHistogramReplaceInfo("instance_golang_gc_pauses", 1000_000_000, latencyBuckets).
+		replacedWith("/sched/pauses/total/gc:seconds"),
+	"/sched/pauses/total/gc:seconds": newMetricsHistogramReplaceInfo("instance_golang_gc_pauses", 1000_000_000, latencyBuckets),
+	"/sched/latencies:seconds":       newMetricsHistogramReplaceInfo("instance_golang_sched_latencies", 1000_000_000, latencyBuckets),
 }
 
+// latencyBuckets is the compacted buckets(in nanoseconds) of the runtime time histograms,
+// the runtime provides more than a hundred of buckets for them, which is too heavy to report
+//
+//nolint
+var latencyBuckets = []float64{
+	0, 1_000, 5_000, 10_000, 25_000, 50_000, 100_000, 250_000, 500_000,
+	1_000_000, 2_500_000, 5_000_000, 10_000_000, 25_000_000, 50_000_000,
+	100_000_000, 250_000_000, 500_000_000, 1000_000_000,
+}
+
+// sizeBuckets is the compacted buckets(in bytes) of the runtime allocation size histograms, grouped by power of two
+//
+//nolint
+var sizeBuckets = []float64{0, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768}
+
 //nolint
 var combinedMetrics = []*meterInfo{
 	newCombinedGaugeInfo("instance_golang_memory_heap_labeled", []string{
 		"/memory/classes/heap/free:bytes", "/memory/classes/heap/objects:bytes", "/memory/classes/heap/released:bytes",
 		"/memory/classes/heap/stacks:bytes", "/memory/classes/heap/unused:bytes",
 	}, "type", "total"),
+	newDeltaRatioGaugeInfo("instance_golang_gc_cpu_fraction",
+		"/cpu/classes/gc/total:cpu-seconds", "/cpu/classes/total:cpu-seconds"),
 }
 
 //nolint
 //skywalking:init
 func registerMetrics() {
 	allMetrics := original_metrics.All()
+	supported := make(map[string]bool, len(allMetrics))
+	for _, m := range allMetrics {
+		supported[m.Name] = true
+	}
 	samples := make([]original_metrics.Sample, 0)
+	sampleNames := make(map[string]bool)
 	infos := make(map[string]*meterInfo)
 	combinedInfos := make([]*meterInfo, 0)
 
 	for _, m := range allMetrics {
-		info := nameReplacing[m.Name]
+		info := sendingMeterInfo(m.Name, supported)
 		if info == nil {
 			continue
 		}
 
-		sample := original_metrics.Sample{Name: m.Name}
-		samples = append(samples, sample)
-		info.init(sample)
+		samples = append(samples, original_metrics.Sample{Name: m.Name})
+		sampleNames[m.Name] = true
+		info.init()
 		infos[m.Name] = info
 	}
 
 	for _, info := range combinedMetrics {
+		if !info.shouldSend() || !info.combinedSupported(supported) {
+			continue
+		}
+		// the combined metrics needs reading the samples even though they are not sent
+		for _, name := range info.needsMetricsNames {
+			if !sampleNames[name] {
+				samples = append(samples, original_metrics.Sample{Name: name})
+				sampleNames[name] = true
+			}
+		}
 		info.initWithCombined()
 		combinedInfos = append(combinedInfos, info)
 	}
@@ -126,24 +166,39 @@ func registerMetrics() {
 	})
 }
 
+// sendingMeterInfo returns the meter info of the runtime metric when it should be sent,
+// the metric is ignored when the metric which replaced it is supported by the runtime
+func sendingMeterInfo(name string, supported map[string]bool) *meterInfo {
+	info := nameReplacing[name]
+	if info == nil || !info.shouldSend() || supported[info.replacedBy] {
+		return nil
+	}
+	return info
+}
+
 type meterInfo struct {
 	// basic info
 	name              string
 	tagOpts           []metrics.Opt
 	isHistogram       bool
 	histogramMultiple int
+	histogramBuckets  []float64
+	// ignore current metric when the replaced metric is supported by the runtime
+	replacedBy string
 
 	// metric value
 	gaugeValue           float64
 	gaugeMetric          metrics.Gauge
-	latestHistogramValue []int64
+	latestHistogramValue []uint64
 	histogramMetric      metrics.Histogram
-	histogramStartInx    int
 
 	// combined metrics
 	needsMetricsNames   []string
 	combinedGaugeMetric metrics.Gauge
 	combinedGaugeValue  float64
+	// the combined value is the ratio of the metrics increment: delta(needs[0]) / delta(needs[1])
+	isDeltaRatio         bool
+	latestCombinedValues []float64
 }
 
 func newMetricsGaugeReplaceInfo(name string, tags ...string) *meterInfo {
@@ -157,8 +212,8 @@ func newMetricsGaugeReplaceInfo(name string, tags ...string) *meterInfo {
 	return meter
 }
 
-func newMetricsHistogramReplaceInfo(name string, multiples int) *meterInfo {
-	meter := &meterInfo{name: name, isHistogram: true, histogramMultiple: multiples}
+func newMetricsHistogramReplaceInfo(name string, multiples int, buckets []float64) *meterInfo {
+	meter := &meterInfo{name: name, isHistogram: true, histogramMultiple: multiples, histogramBuckets: buckets}
 	return meter
 }
 
@@ -173,7 +228,39 @@ func newCombinedGaugeInfo(name string, needsMetrics []string, tags ...string) *m
 	return meter
 }
 
-func (m *meterInfo) init(sample original_metrics.Sample) {
+func newDeltaRatioGaugeInfo(name, numerator, denominator string) *meterInfo {
+	meter := newCombinedGaugeInfo(name, []string{numerator, denominator})
+	meter.isDeltaRatio = true
+	return meter
+}
+
+func (m *meterInfo) replacedWith(name string) *meterInfo {
+	m.replacedBy = name
+	return m
+}
+
+func (m *meterInfo) shouldSend() bool {
+	if len(config.Series) == 0 {
+		return true
+	}
+	for _, s := range config.Series {
+		if s == m.name {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *meterInfo) combinedSupported(supported map[string]bool) bool {
+	for _, name := range m.needsMetricsNames {
+		if !supported[name] {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *meterInfo) init() {
 	if !m.isHistogram {
 		m.gaugeMetric = metrics.NewGauge(m.name, func() float64 {
 			return m.gaugeValue
@@ -181,8 +268,7 @@ func (m *meterInfo) init(sample original_metrics.Sample) {
 		return
 	}
 
-	original_metrics.Read([]original_metrics.Sample{sample})
-	m.initHistogramIfNeeds(sample)
+	m.histogramMetric = metrics.NewHistogram(m.name, m.histogramBuckets, m.tagOpts...)
 }
 
 func (m *meterInfo) initWithCombined() {
@@ -199,55 +285,39 @@ func (m *meterInfo) updateMetricValue(sample original_metrics.Sample) {
 		return
 	}
 
-	m.initHistogramIfNeeds(sample)
-	if m.histogramMetric == nil || m.latestHistogramValue == nil {
-		return
+	if histogram, ok := m.readingHistogram(sample); ok {
+		m.updateHistogramValue(histogram)
 	}
-	histogram, ok := m.readingHistogram(sample)
-	if !ok {
+}
+
+func (m *meterInfo) updateHistogramValue(histogram *original_metrics.Float64Histogram) {
+	if m.histogramMetric == nil {
 		return
 	}
-	for i, val := range histogram.Counts {
-		if i < m.histogramStartInx {
-			continue
-		}
+	if m.latestHistogramValue == nil {
+		m.latestHistogramValue = make([]uint64, len(histogram.Counts))
+	}
+	// the runtime histogram is cumulative, only observe the increment of each bucket.
+	// The count of the bucket [Buckets[i], Buckets[i+1]) is observed by the lower bound,
+	// so it would be compacted into the reported bucket which contains the lower bound
+	for i, count := range histogram.Counts {
 		if i >= len(m.latestHistogramValue) {
 			break
 		}
-		newestValue := int64(val)
-		if add := newestValue - m.latestHistogramValue[i]; add > 0 {
-			m.histogramMetric.ObserveWithCount(histogram.Buckets[i]*float64(m.histogramMultiple), add)
-			m.latestHistogramValue[i] = newestValue
+		if count <= m.latestHistogramValue[i] {
+			continue
 		}
+		m.histogramMetric.ObserveWithCount(m.histogramLowerBound(histogram.Buckets[i]), int64(count-m.latestHistogramValue[i]))
+		m.latestHistogramValue[i] = count
 	}
 }
 
-func (m *meterInfo) initHistogramIfNeeds(sample original_metrics.Sample) {
-	if m.histogramMetric != nil {
-		return
-	}
-	histogram, ok := m.readingHistogram(sample)
-	if !ok {
-		return
+func (m *meterInfo) histogramLowerBound(bucket float64) float64 {
+	// the first bucket of the time histograms starts from -Inf
+	if bucket < 0 {
+		return 0
 	}
-	float64s := make([]float64, 0, len(histogram.Buckets))
-	val := make([]int64, 0, len(histogram.Buckets))
-	var startInx = 0
-	var hasAdded = false
-	for _, b := range histogram.Buckets {
-		if b > math.MaxFloat64 || b < 0 {
-			if !hasAdded {
-				startInx++
-			}
-			continue
-		}
-		hasAdded = true
-		float64s = append(float64s, b*float64(m.histogramMultiple))
-		val = append(val, 0)
-	}
-	m.histogramStartInx = startInx
-	m.histogramMetric = metrics.NewHistogram(m.name, float64s, m.tagOpts...)
-	m.latestHistogramValue = val
+	return bucket * float64(m.histogramMultiple)
 }
 
 func (m *meterInfo) readingFloat64(s original_metrics.Sample) (float64, bool) {
@@ -269,16 +339,36 @@ func (m *meterInfo) readingHistogram(s original_metrics.Sample) (*original_metri
 }
 
 func (m *meterInfo) updateCombinedMetricValue(samples []original_metrics.Sample) {
-	var sum float64
-	for _, name := range m.needsMetricsNames {
+	values := make([]float64, len(m.needsMetricsNames))
+	for i, name := range m.needsMetricsNames {
 		for _, sample := range samples {
 			if sample.Name == name {
 				if v, ok := m.readingFloat64(sample); ok {
-					sum += v
+					values[i] = v
 				}
 				break
 			}
 		}
 	}
-	m.combinedGaugeValue = sum
+	m.updateCombinedValues(values)
+}
+
+func (m *meterInfo) updateCombinedValues(values []float64) {
+	if !m.isDeltaRatio {
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		m.combinedGaugeValue = sum
+		return
+	}
+
+	if m.latestCombinedValues != nil {
+		numerator, denominator := values[0]-m.latestCombinedValues[0], values[1]-m.latestCombinedValues[1]
+		// keep the latest ratio when no increment of the denominator
+		if denominator > 0 {
+			m.combinedGaugeValue = numerator / denominator
+		}
+	}
+	m.latestCombinedValues = values
 }
@@ -0,0 +1,106 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package runtimemetrics
+
+import (
+	"math"
+	original_metrics "runtime/metrics"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordHistogram struct {
+	observed map[float64]int64
+}
+
+func (h *recordHistogram) Observe(val float64) {
+	h.ObserveWithCount(val, 1)
+}
+
+func (h *recordHistogram) ObserveWithCount(val float64, count int64) {
+	h.observed[val] += count
+}
+
+func TestHistogramIncrementObservedByLowerBound(t *testing.T) {
+	recorder := &recordHistogram{observed: make(map[float64]int64)}
+	info := newMetricsHistogramReplaceInfo("test_latency", 1000_000_000, latencyBuckets)
+	info.histogramMetric = recorder
+
+	// 2^-20 and 2^-9 seconds, which are exactly representable in nanoseconds
+	histogram := &original_metrics.Float64Histogram{
+		Counts:  []uint64{1, 2, 0, 3},
+		Buckets: []float64{math.Inf(-1), 0.00000095367431640625, 0.001953125, 0.00390625, math.Inf(1)},
+	}
+	info.updateHistogramValue(histogram)
+	assert.Equal(t, map[float64]int64{0: 1, 953.67431640625: 2, 3906250: 3}, recorder.observed)
+
+	// only the increment of the cumulative runtime histogram should be observed
+	recorder.observed = make(map[float64]int64)
+	histogram.Counts = []uint64{1, 5, 0, 3}
+	info.updateHistogramValue(histogram)
+	assert.Equal(t, map[float64]int64{953.67431640625: 3}, recorder.observed)
+}
+
+func TestCompactedBucketsAscending(t *testing.T) {
+	for _, buckets := range [][]float64{latencyBuckets, sizeBuckets} {
+		assert.Equal(t, float64(0), buckets[0], "the compacted buckets should start from zero")
+		for i := 1; i < len(buckets); i++ {
+			assert.Less(t, buckets[i-1], buckets[i], "the compacted buckets should be ascending")
+		}
+	}
+}
+
+func TestDeprecatedMetricsReplaced(t *testing.T) {
+	supported := map[string]bool{"/gc/pauses:seconds": true, "/sched/pauses/total/gc:seconds": true}
+	assert.Nil(t, sendingMeterInfo("/gc/pauses:seconds", supported), "deprecated metric should be ignored")
+	assert.NotNil(t, sendingMeterInfo("/sched/pauses/total/gc:seconds", supported))
+
+	// the runtime before go 1.22 only provides the deprecated one
+	supported = map[string]bool{"/gc/pauses:seconds": true}
+	info := sendingMeterInfo("/gc/pauses:seconds", supported)
+	if assert.NotNil(t, info, "deprecated metric should be sent when the replacement is not supported") {
+		assert.Equal(t, "instance_golang_gc_pauses", info.name)
+	}
+
+	assert.Nil(t, sendingMeterInfo("/not/exist:bytes", supported))
+}
+
+func TestGCCPUFractionDelta(t *testing.T) {
+	info := newDeltaRatioGaugeInfo("test_gc_cpu_fraction", "gc", "total")
+
+	// the first reading only records the baseline
+	info.updateCombinedValues([]float64{1, 10})
+	assert.Equal(t, float64(0), info.combinedGaugeValue)
+
+	info.updateCombinedValues([]float64{2, 20})
+	assert.Equal(t, 0.1, info.combinedGaugeValue)
+
+	// keep the latest ratio when the denominator is not increased
+	info.updateCombinedValues([]float64{3, 20})
+	assert.Equal(t, 0.1, info.combinedGaugeValue)
+
+	info.updateCombinedValues([]float64{6, 30})
+	assert.Equal(t, 0.3, info.combinedGaugeValue)
+}
+
+func TestCombinedGaugeSum(t *testing.T) {
+	info := newCombinedGaugeInfo("test_total", []string{"a", "b", "c"})
+	info.updateCombinedValues([]float64{1, 2, 3})
+	assert.Equal(t, float64(6), info.combinedGaugeValue)
+}
@@ -0,0 +1,103 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sarama
+
+import (
+	"strconv"
+
+	"github.com/IBM/sarama"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// PartitionMessageInterceptor traces the message from the AsyncProducer input channel,
+// the span is finished when the message is returned from the success or error channel
+type PartitionMessageInterceptor struct {
+}
+
+func (p *PartitionMessageInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (p *PartitionMessageInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if err, ok := result[0].(error); ok && err != nil {
+		return nil
+	}
+	msg := invocation.Args()[0].(*sarama.ProducerMessage)
+	// the message sent by the SyncProducer or retried is traced already
+	if getMessageSpan(msg) != nil {
+		return nil
+	}
+	producer := invocation.CallerInstance().(*nativeTopicProducer)
+	// the message is sent through the input channel, so no code runs in the sender goroutine to capture its tracing context,
+	// and the topic producer goroutine would inherit the tracing context when the producer is created,
+	// clean it to prevent the message from linking to the unrelated trace, the span starts a new trace
+	tracing.CleanContext()
+	span, err := createProducerSpan(getPeerInfo(producer.parent), msg.Topic, msg)
+	if err != nil {
+		return err
+	}
+	span.Tag(tagMQPartition, strconv.FormatInt(int64(msg.Partition), 10))
+	span.PrepareAsync()
+	span.End()
+	setMessageSpan(msg, &producerSpan{span: span, async: true})
+	return nil
+}
+
+type ReturnSuccessesInterceptor struct {
+}
+
+func (r *ReturnSuccessesInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	for _, msg := range invocation.Args()[0].([]*sarama.ProducerMessage) {
+		finishAsyncProducerSpan(msg, nil)
+	}
+	return nil
+}
+
+func (r *ReturnSuccessesInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+type ReturnErrorInterceptor struct {
+}
+
+func (r *ReturnErrorInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	err, _ := invocation.Args()[1].(error)
+	finishAsyncProducerSpan(invocation.Args()[0].(*sarama.ProducerMessage), err)
+	return nil
+}
+
+func (r *ReturnErrorInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	return nil
+}
+
+func finishAsyncProducerSpan(msg *sarama.ProducerMessage, err error) {
+	producer := getMessageSpan(msg)
+	if producer == nil || !producer.async {
+		return
+	}
+	setMessageSpan(msg, nil)
+	if err != nil {
+		producer.span.Tag(tracing.TagMQStatus, err.Error())
+		producer.span.Error(err.Error())
+	} else {
+		producer.span.Tag(tagMQOffset, strconv.FormatInt(msg.Offset, 10))
+	}
+	producer.span.AsyncFinish()
+}
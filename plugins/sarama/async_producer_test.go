@@ -0,0 +1,95 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sarama
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+func TestAsyncProducerNotLinkedToCreatorContext(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	creator, err := tracing.CreateLocalSpan("creator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	creatorTraceID := creator.TraceID()
+	// the ids generated by a new tracing context in the same millisecond are the same in the test
+	time.Sleep(2 * time.Millisecond)
+	msg := &sarama.ProducerMessage{Topic: "test-topic", Partition: 2}
+	interceptor := &PartitionMessageInterceptor{}
+	invocation := operator.NewInvocation(&nativeTopicProducer{parent: &nativeAsyncProducer{}}, msg)
+	if err = interceptor.AfterInvoke(invocation, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(msg.Headers) == 0 || string(msg.Headers[0].Key) != "sw8" {
+		t.Fatalf("the tracing context should be injected into the message")
+	}
+	parts := strings.Split(string(msg.Headers[0].Value), "-")
+	traceID, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(traceID) == creatorTraceID {
+		t.Errorf("the message should not be linked to the tracing context of the producer creator")
+	}
+}
+
+func TestAsyncProducerIgnoreRejectedMessage(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	msg := &sarama.ProducerMessage{Topic: "test-topic"}
+	interceptor := &PartitionMessageInterceptor{}
+	invocation := operator.NewInvocation(&nativeTopicProducer{parent: &nativeAsyncProducer{}}, msg)
+	if err := interceptor.AfterInvoke(invocation, errors.New("partition failure")); err != nil {
+		t.Fatal(err)
+	}
+	if len(msg.Headers) != 0 {
+		t.Errorf("the message failed to partition should not be traced")
+	}
+}
+
+func TestAsyncProducerReturnUntracedMessage(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	// the message not traced by the async producer, such as sent by the SyncProducer, should be ignored
+	msg := &sarama.ProducerMessage{Topic: "test-topic"}
+	if err := (&ReturnSuccessesInterceptor{}).BeforeInvoke(operator.NewInvocation(nil, []*sarama.ProducerMessage{msg})); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&ReturnErrorInterceptor{}).BeforeInvoke(operator.NewInvocation(nil, msg, errors.New("failure"))); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if spans := core.GetReportedSpans(); len(spans) != 0 {
+		t.Errorf("no span should be reported for the untraced message, actual %d", len(spans))
+	}
+}
@@ -0,0 +1,126 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sarama
+
+import (
+	"strconv"
+
+	"github.com/IBM/sarama"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	kafkaConsumerPrefix      = "Kafka/"
+	kafkaConsumerSuffix      = "/Consumer"
+	kafkaConsumerComponentID = 41
+	tagMQConsumerGroup       = "mq.consumer_group"
+)
+
+type consumerGroupInfo struct {
+	groupID string
+	peer    string
+}
+
+// NewConsumerGroupInterceptor passes the consumer group info to the consumer created by the group
+type NewConsumerGroupInterceptor struct {
+}
+
+func (n *NewConsumerGroupInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *NewConsumerGroupInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	group, ok := result[0].(*nativeConsumerGroup)
+	if !ok || group == nil {
+		return nil
+	}
+	if instance, ok := group.consumer.(operator.EnhancedInstance); ok && instance != nil {
+		groupID := invocation.Args()[0].(string)
+		client := invocation.Args()[1].(sarama.Client)
+		instance.SetSkyWalkingDynamicField(&consumerGroupInfo{groupID: groupID, peer: brokerAddresses(client)})
+	}
+	return nil
+}
+
+// ParseResponseInterceptor creates an entry span for each message fetched by the partition consumer of the group,
+// the span ends immediately because the messages are delivered to the handler through the channel, so it is not
+// linked to the processing of the message in the ConsumeClaim.
+type ParseResponseInterceptor struct {
+}
+
+func (p *ParseResponseInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (p *ParseResponseInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	messages, ok := result[0].([]*sarama.ConsumerMessage)
+	if !ok || len(messages) == 0 {
+		return nil
+	}
+	child := invocation.CallerInstance().(*nativePartitionConsumer)
+	info := getConsumerGroupInfo(child.consumer)
+	if info == nil {
+		return nil
+	}
+	// the messages are parsed in the goroutine of the partition consumer,
+	// clean the inherited tracing context to prevent the messages from linking to the unrelated trace
+	tracing.CleanContext()
+	var lastErr error
+	for _, msg := range messages {
+		if err := traceMessage(info, msg); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func getConsumerGroupInfo(consumer interface{}) *consumerGroupInfo {
+	instance, ok := consumer.(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return nil
+	}
+	info, _ := instance.GetSkyWalkingDynamicField().(*consumerGroupInfo)
+	return info
+}
+
+func traceMessage(info *consumerGroupInfo, msg *sarama.ConsumerMessage) error {
+	span, err := tracing.CreateEntrySpan(kafkaConsumerPrefix+msg.Topic+kafkaConsumerSuffix, func(headerKey string) (string, error) {
+		for _, header := range msg.Headers {
+			if header != nil && string(header.Key) == headerKey {
+				return string(header.Value), nil
+			}
+		}
+		return "", nil
+	},
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(kafkaConsumerComponentID),
+		tracing.WithTag(tracing.TagMQBroker, info.peer),
+		tracing.WithTag(tracing.TagMQTopic, msg.Topic),
+		tracing.WithTag(tagMQPartition, strconv.FormatInt(int64(msg.Partition), 10)),
+		tracing.WithTag(tagMQOffset, strconv.FormatInt(msg.Offset, 10)),
+		tracing.WithTag(tagMQConsumerGroup, info.groupID),
+	)
+	if err != nil {
+		return err
+	}
+	span.SetPeer(info.peer)
+	span.End()
+	return nil
+}
@@ -0,0 +1,118 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sarama
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// the native consumer is enhanced by the agent, provide the consumer group info for testing
+func (c *nativeConsumer) GetSkyWalkingDynamicField() interface{} {
+	return &consumerGroupInfo{groupID: "test-group", peer: "broker:9092"}
+}
+
+func (c *nativeConsumer) SetSkyWalkingDynamicField(interface{}) {
+}
+
+func TestParseResponsePropagation(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	producerMsg := &sarama.ProducerMessage{Topic: "test-topic"}
+	span, err := createProducerSpan("broker:9092", producerMsg.Topic, producerMsg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	span.End()
+
+	consumerMsg := &sarama.ConsumerMessage{Topic: "test-topic", Partition: 1, Offset: 10}
+	for i := range producerMsg.Headers {
+		consumerMsg.Headers = append(consumerMsg.Headers, &producerMsg.Headers[i])
+	}
+	interceptor := &ParseResponseInterceptor{}
+	invocation := operator.NewInvocation(&nativePartitionConsumer{consumer: &nativeConsumer{}}, &sarama.FetchResponse{})
+	if err = interceptor.AfterInvoke(invocation, []*sarama.ConsumerMessage{consumerMsg}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	producer := findReportedSpan(t, "Kafka/test-topic/Producer")
+	consumer := findReportedSpan(t, "Kafka/test-topic/Consumer")
+	if len(consumer.Refs()) != 1 {
+		t.Fatalf("expected 1 ref of the consumer span, actual %d", len(consumer.Refs()))
+	}
+	if ref := consumer.Refs()[0]; ref.GetTraceID() != producer.Context().GetTraceID() ||
+		ref.GetParentSegmentID() != producer.Context().GetSegmentID() {
+		t.Errorf("the consumer span is not linked to the producer span")
+	}
+	if consumer.Peer() != "broker:9092" || consumer.ComponentID() != kafkaConsumerComponentID {
+		t.Errorf("unexpected peer %s or component %d", consumer.Peer(), consumer.ComponentID())
+	}
+	tags := spanTags(consumer)
+	for k, v := range map[string]string{"mq.broker": "broker:9092", "mq.topic": "test-topic", "mq.partition": "1",
+		"mq.offset": "10", "mq.consumer_group": "test-group"} {
+		if tags[k] != v {
+			t.Errorf("expected tag %s=%s, actual %s", k, v, tags[k])
+		}
+	}
+}
+
+func TestParseResponseNotLinkedToPartitionConsumerContext(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	// the partition consumer goroutine inherits the tracing context when the consumer group is created
+	creator, err := tracing.CreateLocalSpan("creator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	creatorTraceID := creator.TraceID()
+	// the ids generated by a new tracing context in the same millisecond are the same in the test
+	time.Sleep(2 * time.Millisecond)
+	messages := []*sarama.ConsumerMessage{{Topic: "test-topic", Offset: 1}, {Topic: "test-topic", Offset: 2}}
+	interceptor := &ParseResponseInterceptor{}
+	invocation := operator.NewInvocation(&nativePartitionConsumer{consumer: &nativeConsumer{}}, &sarama.FetchResponse{})
+	if err = interceptor.AfterInvoke(invocation, messages, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	var consumers []string
+	for len(consumers) < 2 && time.Now().Before(deadline) {
+		consumers = consumers[:0]
+		for _, s := range core.GetReportedSpans() {
+			if s.OperationName() != "Kafka/test-topic/Consumer" {
+				continue
+			}
+			if len(s.Refs()) != 0 || s.Context().GetTraceID() == creatorTraceID {
+				t.Fatalf("the consumer span should start a new trace")
+			}
+			consumers = append(consumers, spanTags(s)[tagMQOffset])
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if len(consumers) != 2 {
+		t.Errorf("expected the spans of the 2 parsed messages, actual offsets: %v", consumers)
+	}
+}
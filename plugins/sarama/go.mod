@@ -0,0 +1,5 @@
+module github.com/apache/skywalking-go/plugins/sarama
+
+go 1.24
+
+require github.com/IBM/sarama v1.45.2 // indirect
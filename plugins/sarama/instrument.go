@@ -0,0 +1,171 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sarama
+
+import (
+	"embed"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+	basePackage string
+}
+
+// NewInstrument for the github.com/IBM/sarama
+func NewInstrument() *Instrument {
+	return &Instrument{basePackage: "github.com/IBM/sarama"}
+}
+
+// NewShopifyInstrument for the github.com/Shopify/sarama, which is the previous name of the IBM/sarama
+func NewShopifyInstrument() *Instrument {
+	return &Instrument{basePackage: "github.com/Shopify/sarama"}
+}
+
+func (i *Instrument) Name() string {
+	return "sarama"
+}
+
+func (i *Instrument) BasePackage() string {
+	return i.basePackage
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return true
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At: instrument.NewStaticMethodEnhance("newAsyncProducer",
+				instrument.WithArgsCount(1),
+				instrument.WithArgType(0, "Client"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "AsyncProducer"),
+				instrument.WithResultType(1, "error"),
+			),
+			Interceptor: "NewAsyncProducerInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At: instrument.NewMethodEnhance("*syncProducer", "SendMessage",
+				instrument.WithArgsCount(1),
+				instrument.WithArgType(0, "*ProducerMessage"),
+				instrument.WithResultCount(3),
+				instrument.WithResultType(0, "int32"),
+				instrument.WithResultType(1, "int64"),
+				instrument.WithResultType(2, "error"),
+			),
+			Interceptor: "SendMessageInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At: instrument.NewMethodEnhance("*syncProducer", "SendMessages",
+				instrument.WithArgsCount(1),
+				instrument.WithArgType(0, "[]*ProducerMessage"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error"),
+			),
+			Interceptor: "SendMessagesInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At: instrument.NewMethodEnhance("*topicProducer", "partitionMessage",
+				instrument.WithArgsCount(1),
+				instrument.WithArgType(0, "*ProducerMessage"),
+				instrument.WithResultCount(1),
+				instrument.WithResultType(0, "error"),
+			),
+			Interceptor: "PartitionMessageInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At: instrument.NewMethodEnhance("*asyncProducer", "returnSuccesses",
+				instrument.WithArgsCount(1),
+				instrument.WithArgType(0, "[]*ProducerMessage"),
+				instrument.WithResultCount(0),
+			),
+			Interceptor: "ReturnSuccessesInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At: instrument.NewMethodEnhance("*asyncProducer", "returnError",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "*ProducerMessage"),
+				instrument.WithArgType(1, "error"),
+				instrument.WithResultCount(0),
+			),
+			Interceptor: "ReturnErrorInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At: instrument.NewStaticMethodEnhance("newConsumerGroup",
+				instrument.WithArgsCount(2),
+				instrument.WithArgType(0, "string"),
+				instrument.WithArgType(1, "Client"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "ConsumerGroup"),
+				instrument.WithResultType(1, "error"),
+			),
+			Interceptor: "NewConsumerGroupInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At: instrument.NewMethodEnhance("*partitionConsumer", "parseResponse",
+				instrument.WithArgsCount(1),
+				instrument.WithArgType(0, "*FetchResponse"),
+				instrument.WithResultCount(2),
+				instrument.WithResultType(0, "[]*ConsumerMessage"),
+				instrument.WithResultType(1, "error"),
+			),
+			Interceptor: "ParseResponseInterceptor",
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At:          instrument.NewStructEnhance("asyncProducer"),
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At:          instrument.NewStructEnhance("ProducerMessage"),
+		},
+		{
+			PackagePath: "",
+			PackageName: "sarama",
+			At:          instrument.NewStructEnhance("consumer"),
+		},
+	}
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
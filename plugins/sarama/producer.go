@@ -0,0 +1,201 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sarama
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/IBM/sarama"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	kafkaProducerPrefix      = "Kafka/"
+	kafkaProducerSuffix      = "/Producer"
+	kafkaProducerComponentID = 40
+	tagMQPartition           = "mq.partition"
+	tagMQOffset              = "mq.offset"
+	semicolon                = ";"
+	comma                    = ","
+)
+
+// producerSpan is bound to the message when it's sending, to prevent the message from being traced twice
+type producerSpan struct {
+	span tracing.Span
+	// the span is created by the async producer, and finished when the message is acknowledged
+	async bool
+}
+
+type NewAsyncProducerInterceptor struct {
+}
+
+func (n *NewAsyncProducerInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *NewAsyncProducerInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if instance, ok := result[0].(operator.EnhancedInstance); ok && instance != nil {
+		client := invocation.Args()[0].(sarama.Client)
+		instance.SetSkyWalkingDynamicField(brokerAddresses(client))
+	}
+	return nil
+}
+
+type SendMessageInterceptor struct {
+}
+
+func (s *SendMessageInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	producer := invocation.CallerInstance().(*nativeSyncProducer)
+	msg := invocation.Args()[0].(*sarama.ProducerMessage)
+	span, err := createProducerSpan(getPeerInfo(producer.producer), msg.Topic, msg)
+	if err != nil {
+		return err
+	}
+	setMessageSpan(msg, &producerSpan{span: span})
+	invocation.SetContext(span)
+	return nil
+}
+
+func (s *SendMessageInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	span := invocation.GetContext().(tracing.Span)
+	setMessageSpan(invocation.Args()[0], nil)
+	if err, ok := result[2].(error); ok && err != nil {
+		span.Tag(tracing.TagMQStatus, err.Error())
+		span.Error(err.Error())
+	} else {
+		span.Tag(tagMQPartition, strconv.FormatInt(int64(result[0].(int32)), 10))
+		span.Tag(tagMQOffset, strconv.FormatInt(result[1].(int64), 10))
+	}
+	span.End()
+	return nil
+}
+
+type SendMessagesInterceptor struct {
+}
+
+func (s *SendMessagesInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	producer := invocation.CallerInstance().(*nativeSyncProducer)
+	msgs := invocation.Args()[0].([]*sarama.ProducerMessage)
+	if len(msgs) == 0 {
+		return nil
+	}
+	topics := make([]string, 0, 1)
+	for _, msg := range msgs {
+		if !containsString(topics, msg.Topic) {
+			topics = append(topics, msg.Topic)
+		}
+	}
+	span, err := createProducerSpan(getPeerInfo(producer.producer), strings.Join(topics, comma), msgs...)
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		setMessageSpan(msg, &producerSpan{span: span})
+	}
+	invocation.SetContext(span)
+	return nil
+}
+
+func (s *SendMessagesInterceptor) AfterInvoke(invocation operator.Invocation, result ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	span := invocation.GetContext().(tracing.Span)
+	for _, msg := range invocation.Args()[0].([]*sarama.ProducerMessage) {
+		setMessageSpan(msg, nil)
+	}
+	if err, ok := result[0].(error); ok && err != nil {
+		span.Tag(tracing.TagMQStatus, err.Error())
+		span.Error(err.Error())
+	}
+	span.End()
+	return nil
+}
+
+func createProducerSpan(peer, topic string, msgs ...*sarama.ProducerMessage) (tracing.Span, error) {
+	return tracing.CreateExitSpan(kafkaProducerPrefix+topic+kafkaProducerSuffix, peer, func(headerKey, headerValue string) error {
+		for _, msg := range msgs {
+			msg.Headers = setRecordHeader(msg.Headers, headerKey, headerValue)
+		}
+		return nil
+	},
+		tracing.WithLayer(tracing.SpanLayerMQ),
+		tracing.WithComponent(kafkaProducerComponentID),
+		tracing.WithTag(tracing.TagMQBroker, peer),
+		tracing.WithTag(tracing.TagMQTopic, topic),
+	)
+}
+
+// setRecordHeader replace the header if exists, for the message may be sent more than once
+func setRecordHeader(headers []sarama.RecordHeader, key, value string) []sarama.RecordHeader {
+	for i := range headers {
+		if string(headers[i].Key) == key {
+			headers[i].Value = []byte(value)
+			return headers
+		}
+	}
+	return append(headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+}
+
+func getMessageSpan(msg interface{}) *producerSpan {
+	instance, ok := msg.(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return nil
+	}
+	span, _ := instance.GetSkyWalkingDynamicField().(*producerSpan)
+	return span
+}
+
+func setMessageSpan(msg interface{}, span *producerSpan) {
+	if instance, ok := msg.(operator.EnhancedInstance); ok && instance != nil {
+		instance.SetSkyWalkingDynamicField(span)
+	}
+}
+
+func brokerAddresses(client sarama.Client) string {
+	brokers := client.Brokers()
+	addresses := make([]string, 0, len(brokers))
+	for _, broker := range brokers {
+		addresses = append(addresses, broker.Addr())
+	}
+	return strings.Join(addresses, semicolon)
+}
+
+func getPeerInfo(field interface{}) string {
+	instance, ok := field.(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return ""
+	}
+	peer, _ := instance.GetSkyWalkingDynamicField().(string)
+	return peer
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
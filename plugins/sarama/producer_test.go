@@ -0,0 +1,159 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sarama
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/reporter"
+)
+
+// the native async producer is enhanced by the agent, provide the peer address for testing
+func (p *nativeAsyncProducer) GetSkyWalkingDynamicField() interface{} {
+	return "broker:9092"
+}
+
+func (p *nativeAsyncProducer) SetSkyWalkingDynamicField(interface{}) {
+}
+
+func findReportedSpan(t *testing.T, name string) reporter.ReportedSpan {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		for _, s := range core.GetReportedSpans() {
+			if s.OperationName() == name {
+				return s
+			}
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("span %q was never reported", name)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func spanTags(span reporter.ReportedSpan) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range span.Tags() {
+		tags[tag.Key] = tag.Value
+	}
+	return tags
+}
+
+func hasHeader(headers []sarama.RecordHeader, key string) bool {
+	for _, header := range headers {
+		if string(header.Key) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func TestSetRecordHeader(t *testing.T) {
+	headers := setRecordHeader(nil, "sw8", "first")
+	headers = setRecordHeader(headers, "custom", "value")
+	// the message sent again should not contain the duplicate header
+	headers = setRecordHeader(headers, "sw8", "second")
+	if len(headers) != 2 {
+		t.Fatalf("expected 2 headers, actual %d", len(headers))
+	}
+	if string(headers[0].Key) != "sw8" || string(headers[0].Value) != "second" {
+		t.Errorf("unexpected header %s=%s", headers[0].Key, headers[0].Value)
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	msg := &sarama.ProducerMessage{Topic: "test-topic"}
+	interceptor := &SendMessageInterceptor{}
+	invocation := operator.NewInvocation(&nativeSyncProducer{producer: &nativeAsyncProducer{}}, msg)
+	if err := interceptor.BeforeInvoke(invocation); err != nil {
+		t.Fatal(err)
+	}
+	if !hasHeader(msg.Headers, "sw8") {
+		t.Fatalf("the tracing context should be injected into the message")
+	}
+	if err := interceptor.AfterInvoke(invocation, int32(1), int64(10), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	span := findReportedSpan(t, "Kafka/test-topic/Producer")
+	if span.Peer() != "broker:9092" || span.ComponentID() != kafkaProducerComponentID {
+		t.Errorf("unexpected peer %s or component %d", span.Peer(), span.ComponentID())
+	}
+	tags := spanTags(span)
+	for k, v := range map[string]string{"mq.broker": "broker:9092", "mq.topic": "test-topic", "mq.partition": "1", "mq.offset": "10"} {
+		if tags[k] != v {
+			t.Errorf("expected tag %s=%s, actual %s", k, v, tags[k])
+		}
+	}
+}
+
+func TestSendMessagesWithError(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	msgs := []*sarama.ProducerMessage{{Topic: "topic-a"}, {Topic: "topic-b"}, {Topic: "topic-a"}}
+	interceptor := &SendMessagesInterceptor{}
+	invocation := operator.NewInvocation(&nativeSyncProducer{producer: &nativeAsyncProducer{}}, msgs)
+	if err := interceptor.BeforeInvoke(invocation); err != nil {
+		t.Fatal(err)
+	}
+	for _, msg := range msgs {
+		if !hasHeader(msg.Headers, "sw8") {
+			t.Fatalf("the tracing context should be injected into all the messages")
+		}
+	}
+	if err := interceptor.AfterInvoke(invocation, errors.New("send failure")); err != nil {
+		t.Fatal(err)
+	}
+
+	span := findReportedSpan(t, "Kafka/topic-a,topic-b/Producer")
+	if !span.IsError() {
+		t.Errorf("the span should be marked as error")
+	}
+	if tags := spanTags(span); tags["mq.status"] != "send failure" {
+		t.Errorf("expected tag mq.status=send failure, actual %s", tags["mq.status"])
+	}
+}
+
+func TestSendMessagesWithoutMessage(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	interceptor := &SendMessagesInterceptor{}
+	invocation := operator.NewInvocation(&nativeSyncProducer{producer: &nativeAsyncProducer{}}, []*sarama.ProducerMessage{})
+	if err := interceptor.BeforeInvoke(invocation); err != nil {
+		t.Fatal(err)
+	}
+	if err := interceptor.AfterInvoke(invocation, nil); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if spans := core.GetReportedSpans(); len(spans) != 0 {
+		t.Errorf("no span should be created without message, actual %d", len(spans))
+	}
+}
@@ -0,0 +1,50 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sarama
+
+import (
+	"github.com/IBM/sarama"
+)
+
+//skywalking:native github.com/IBM/sarama syncProducer
+type nativeSyncProducer struct {
+	producer *nativeAsyncProducer
+}
+
+//skywalking:native github.com/IBM/sarama topicProducer
+type nativeTopicProducer struct {
+	parent *nativeAsyncProducer
+}
+
+//skywalking:native github.com/IBM/sarama asyncProducer
+type nativeAsyncProducer struct {
+}
+
+//skywalking:native github.com/IBM/sarama consumerGroup
+type nativeConsumerGroup struct {
+	consumer sarama.Consumer
+}
+
+//skywalking:native github.com/IBM/sarama consumer
+type nativeConsumer struct {
+}
+
+//skywalking:native github.com/IBM/sarama partitionConsumer
+type nativePartitionConsumer struct {
+	consumer *nativeConsumer
+}
@@ -0,0 +1,46 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clickhouse
+
+import (
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+// PrepareBatchInterceptor creates the span for preparing the batch(the server returns the block structure),
+// and records the instance info and statement into the batch for sending.
+type PrepareBatchInterceptor struct {
+}
+
+func (n *PrepareBatchInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return createConnExitSpan(invocation, "PrepareBatch", nil)
+}
+
+func (n *PrepareBatchInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	endSpan(invocation, results[1])
+	if err, ok := results[1].(error); ok && err != nil {
+		return nil
+	}
+	info := getDBInfo(invocation.CallerInstance())
+	if info == nil {
+		return nil
+	}
+	if batch, ok := results[0].(operator.EnhancedInstance); ok && batch != nil {
+		batch.SetSkyWalkingDynamicField(&batchInfo{db: info, statement: invocation.Args()[1].(string)})
+	}
+	return nil
+}
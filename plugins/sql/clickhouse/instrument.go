@@ -0,0 +1,156 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clickhouse
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "sql"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "github.com/ClickHouse/clickhouse-go/v2"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return strings.HasPrefix(version, "v2.")
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At:          instrument.NewStructEnhance("clickhouse"),
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At:          instrument.NewStructEnhance("batch"),
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At:          instrument.NewStructEnhance("httpBatch"),
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewStaticMethodEnhance("Open",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "*Options"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "driver.Conn"), instrument.WithResultType(1, "error")),
+			Interceptor: "OpenInterceptor",
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewStaticMethodEnhance("OpenDB",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "*Options"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "*sql.DB")),
+			Interceptor: "OpenDBInterceptor",
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*stdDriver", "Open",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "string"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "driver.Conn"), instrument.WithResultType(1, "error")),
+			Interceptor: "StdOpenInterceptor",
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*clickhouse", "Query",
+				instrument.WithArgsCount(3), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "driver.Rows"), instrument.WithResultType(1, "error")),
+			Interceptor: "QueryInterceptor",
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*clickhouse", "QueryRow",
+				instrument.WithArgsCount(3), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "driver.Row")),
+			Interceptor: "QueryRowInterceptor",
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*clickhouse", "Exec",
+				instrument.WithArgsCount(3), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "error")),
+			Interceptor: "ExecInterceptor",
+		},
+		// the batch options are not supported in the early versions, so the arguments count is not checked
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*clickhouse", "PrepareBatch",
+				instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "driver.Batch"), instrument.WithResultType(1, "error")),
+			Interceptor: "PrepareBatchInterceptor",
+		},
+		// the query arguments are not supported in the early versions, so the arguments count is not checked
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*clickhouse", "AsyncInsert",
+				instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "string"), instrument.WithArgType(2, "bool"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "error")),
+			Interceptor: "AsyncInsertInterceptor",
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*batch", "Send",
+				instrument.WithArgsCount(0), instrument.WithResultCount(1), instrument.WithResultType(0, "error")),
+			Interceptor: "BatchSendInterceptor",
+		},
+		{
+			PackageName: "clickhouse",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*httpBatch", "Send",
+				instrument.WithArgsCount(0), instrument.WithResultCount(1), instrument.WithResultType(0, "error")),
+			Interceptor: "BatchSendInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) PluginSourceCodePath() string {
+	return "clickhouse"
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
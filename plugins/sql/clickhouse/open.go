@@ -0,0 +1,142 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clickhouse
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	clickHouseComponentID int32 = 120
+	defaultNativeAddress        = "localhost:9000"
+	defaultHTTPAddress          = "localhost:8123"
+)
+
+// DBInfo is shared by the native connection and the database/sql plugin,
+// it implements the instance info interface of the database/sql plugin.
+type DBInfo struct {
+	PeerAddress  string
+	DatabaseName string
+}
+
+func (i *DBInfo) Peer() string {
+	return i.PeerAddress
+}
+
+func (i *DBInfo) ComponentID() int32 {
+	return clickHouseComponentID
+}
+
+func (i *DBInfo) DBType() string {
+	return "ClickHouse"
+}
+
+func (i *DBInfo) Database() string {
+	return i.DatabaseName
+}
+
+// OpenInterceptor records the instance info into the native connection.
+type OpenInterceptor struct {
+}
+
+func (i *OpenInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (i *OpenInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if err, ok := results[1].(error); ok && err != nil {
+		return nil
+	}
+	instance, ok := results[0].(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return nil
+	}
+	opt, _ := invocation.Args()[0].(*clickhouse.Options)
+	instance.SetSkyWalkingDynamicField(buildDBInfo(opt))
+	return nil
+}
+
+// OpenDBInterceptor records the instance info into the database, the database/sql plugin
+// could not get it because the database is opened by the connector directly.
+type OpenDBInterceptor struct {
+}
+
+func (i *OpenDBInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (i *OpenDBInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	db, ok := results[0].(*sql.DB)
+	if !ok || db == nil {
+		return nil
+	}
+	instance, ok := interface{}(db).(operator.EnhancedInstance)
+	if !ok {
+		return nil
+	}
+	opt, _ := invocation.Args()[0].(*clickhouse.Options)
+	instance.SetSkyWalkingDynamicField(buildDBInfo(opt))
+	return nil
+}
+
+// StdOpenInterceptor provides the instance info for the database/sql plugin,
+// the DSN is only parsed when opening the connection.
+type StdOpenInterceptor struct {
+}
+
+func (i *StdOpenInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (i *StdOpenInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if tracing.GetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey) != true {
+		return nil
+	}
+	dsn, ok := invocation.Args()[0].(string)
+	if !ok {
+		return nil
+	}
+	opt, err := clickhouse.ParseDSN(dsn)
+	if err != nil {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey, buildDBInfo(opt))
+	return nil
+}
+
+// buildDBInfo uses the configured addresses as the peer, the default address is used like the client
+// when no address is configured.
+func buildDBInfo(opt *clickhouse.Options) *DBInfo {
+	if opt == nil {
+		return &DBInfo{PeerAddress: defaultNativeAddress}
+	}
+	info := &DBInfo{PeerAddress: strings.Join(opt.Addr, ","), DatabaseName: opt.Auth.Database}
+	if info.PeerAddress == "" {
+		info.PeerAddress = defaultNativeAddress
+		if opt.Protocol == clickhouse.HTTP {
+			info.PeerAddress = defaultHTTPAddress
+		}
+	}
+	return info
+}
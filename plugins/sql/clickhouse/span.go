@@ -0,0 +1,128 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package clickhouse
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// batchInfo is recorded into the prepared batch, then the span could be created when sending the batch.
+type batchInfo struct {
+	db        *DBInfo
+	statement string
+}
+
+// batchRows is not implemented by the batch in the early versions, the row count tag is ignored then.
+type batchRows interface {
+	Rows() int
+}
+
+func createExitSpan(info *DBInfo, method, statement string, args []interface{}, opts ...tracing.SpanOption) (tracing.Span, error) {
+	if info == nil {
+		return nil, nil
+	}
+	spanOpts := []tracing.SpanOption{
+		tracing.WithComponent(clickHouseComponentID),
+		tracing.WithLayer(tracing.SpanLayerDatabase),
+		tracing.WithTag(tracing.TagDBType, info.DBType()),
+	}
+	if info.DatabaseName != "" {
+		spanOpts = append(spanOpts, tracing.WithTag(tracing.TagDBInstance, info.DatabaseName))
+	}
+	if statement != "" {
+		spanOpts = append(spanOpts, tracing.WithTag(tracing.TagDBStatement, statement))
+	}
+	if config.CollectParameter && len(args) > 0 {
+		spanOpts = append(spanOpts, tracing.WithTag(tracing.TagDBSqlParameters, argsToString(args)))
+	}
+	return tracing.CreateExitSpan(info.DBType()+"/"+method, info.PeerAddress, func(headerKey, headerValue string) error {
+		return nil
+	}, append(spanOpts, opts...)...)
+}
+
+func createConnExitSpan(invocation operator.Invocation, method string, args []interface{}) error {
+	span, err := createExitSpan(getDBInfo(invocation.CallerInstance()), method, invocation.Args()[1].(string), args)
+	if err != nil || span == nil {
+		return err
+	}
+	invocation.SetContext(span)
+	return nil
+}
+
+func createBatchExitSpan(invocation operator.Invocation) error {
+	instance, ok := invocation.CallerInstance().(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return nil
+	}
+	batch, ok := instance.GetSkyWalkingDynamicField().(*batchInfo)
+	if !ok || batch == nil {
+		return nil
+	}
+	var opts []tracing.SpanOption
+	if rows, ok := invocation.CallerInstance().(batchRows); ok {
+		opts = append(opts, tracing.WithTag(tracing.TagDBBatchRows, strconv.Itoa(rows.Rows())))
+	}
+	span, err := createExitSpan(batch.db, "Batch/Send", batch.statement, nil, opts...)
+	if err != nil || span == nil {
+		return err
+	}
+	invocation.SetContext(span)
+	return nil
+}
+
+func getDBInfo(caller interface{}) *DBInfo {
+	instance, ok := caller.(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return nil
+	}
+	info, ok := instance.GetSkyWalkingDynamicField().(*DBInfo)
+	if !ok {
+		return nil
+	}
+	return info
+}
+
+func endSpan(invocation operator.Invocation, err interface{}) {
+	span, ok := invocation.GetContext().(tracing.Span)
+	if !ok || span == nil {
+		return
+	}
+	if e, ok := err.(error); ok && e != nil {
+		span.Error(e.Error())
+	}
+	span.End()
+}
+
+func argsToString(args []interface{}) string {
+	switch len(args) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("%v", args[0])
+	}
+
+	res := fmt.Sprintf("%v", args[0])
+	for _, arg := range args[1:] {
+		res += fmt.Sprintf(", %v", arg)
+	}
+	return res
+}
@@ -33,6 +33,7 @@ type connInfo struct {
 }
 
 func (n *ConnInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	resolveInstanceInfo(invocation)
 	span, info, err := createLocalSpan(invocation.CallerInstance(), "Conn")
 	if err != nil || span == nil {
 		return err
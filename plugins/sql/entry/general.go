@@ -31,6 +31,7 @@ type PrepareInfo struct {
 }
 
 func GeneralPrepareBeforeInvoke(invocation operator.Invocation, method string) error {
+	resolveInstanceInfo(invocation)
 	span, info, err := createLocalSpan(invocation.CallerInstance(), method,
 		tracing.WithTag(tracing.TagDBStatement, invocation.Args()[1].(string)))
 	if err != nil || span == nil {
@@ -67,6 +68,7 @@ type BeginTxInfo struct {
 }
 
 func GeneralBeginTxBeforeInvoke(invocation operator.Invocation, method string) error {
+	resolveInstanceInfo(invocation)
 	span, info, err := createLocalSpan(invocation.CallerInstance(), method)
 	if err != nil || span == nil {
 		return err
@@ -97,6 +99,7 @@ func GeneralBeginTxAfterInvoke(invocation operator.Invocation, result ...interfa
 }
 
 func GeneralExecBeforeInvoke(invocation operator.Invocation, method string) error {
+	resolveInstanceInfo(invocation)
 	span, err := createExitSpan(invocation.CallerInstance(), method,
 		tracing.WithTag(tracing.TagDBStatement, invocation.Args()[1].(string)))
 	if err != nil {
@@ -122,6 +125,7 @@ func GeneralExecAfterInvoke(invocation operator.Invocation, results ...interface
 }
 
 func GeneralPingBeforeInvoke(invocation operator.Invocation, method string) error {
+	resolveInstanceInfo(invocation)
 	span, err := createExitSpan(invocation.CallerInstance(), method)
 	if err != nil {
 		return err
@@ -143,6 +147,7 @@ func GeneralPingAfterInvoke(invocation operator.Invocation, results ...interface
 }
 
 func GeneralQueryBeforeInvoke(invocation operator.Invocation, method string) error {
+	resolveInstanceInfo(invocation)
 	span, err := createExitSpan(invocation.CallerInstance(), method,
 		tracing.WithTag(tracing.TagDBStatement, invocation.Args()[1].(string)))
 	if err != nil {
@@ -0,0 +1,156 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package entry
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	lazyInstanceUnresolved int32 = 0
+	lazyInstanceResolving  int32 = 1
+	lazyInstanceResolved   int32 = 2
+	// the driver does not provide the instance info, the database is not traced
+	lazyInstanceUnknown int32 = 3
+
+	// lazyConnectedRuntimeContextKey is set when the connection of the driver is opened in the current goroutine
+	lazyConnectedRuntimeContextKey = "sqlLazyConnected"
+)
+
+// lazyInstanceInfo holds the instance info of the database which driver only parse the DSN
+// when opening the connection, the info is resolved when the first connection is opened by the database
+type lazyInstanceInfo struct {
+	driverName string
+	state      int32
+	probed     int32
+	info       InstanceInfo
+}
+
+func (l *lazyInstanceInfo) get() InstanceInfo {
+	if atomic.LoadInt32(&l.state) != lazyInstanceResolved {
+		return nil
+	}
+	return l.info
+}
+
+// resolve the instance info provided by the driver plugins when the connection is opened in the current goroutine,
+// the connection may be opened by another goroutine, then keep it unresolved until the next connection is opened
+func (l *lazyInstanceInfo) resolve(db *sql.DB, info InstanceInfo, connected bool) {
+	if info == nil && !connected {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&l.state, lazyInstanceUnresolved, lazyInstanceResolving) {
+		return
+	}
+	if info == nil {
+		// the same as the database opened by the unsupported driver, only register the connection pool metrics
+		registerPoolMetrics(db, l.driverName, unknownPeer)
+		atomic.StoreInt32(&l.state, lazyInstanceUnknown)
+		return
+	}
+	registerPoolMetrics(db, l.driverName, info.Peer())
+	l.info = info
+	atomic.StoreInt32(&l.state, lazyInstanceResolved)
+}
+
+func getLazyInstanceInfo(caller interface{}) *lazyInstanceInfo {
+	instance, ok := caller.(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return nil
+	}
+	lazy, _ := instance.GetSkyWalkingDynamicField().(*lazyInstanceInfo)
+	return lazy
+}
+
+// resolveInstanceInfo opens a connection of the database only once before the first operation,
+// so the instance info could be resolved and the first operation could be traced,
+// otherwise the info is resolved when the connection is opened by the following operations
+func resolveInstanceInfo(invocation operator.Invocation) {
+	db, ok := invocation.CallerInstance().(*sql.DB)
+	if !ok || db == nil {
+		return
+	}
+	lazy := getLazyInstanceInfo(db)
+	if lazy == nil || atomic.LoadInt32(&lazy.state) != lazyInstanceUnresolved {
+		return
+	}
+	ctx, ok := invocation.Args()[0].(context.Context)
+	if !ok || ctx == nil {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&lazy.probed, 0, 1) {
+		return
+	}
+	if conn, err := db.Conn(ctx); err == nil {
+		_ = conn.Close()
+	}
+}
+
+// OpenConnectionInterceptor resolves the lazy instance info when the database opens the connection,
+// which intercepts the DB.conn in the operation goroutine and the DB.openNewConnection in the opener goroutine
+type OpenConnectionInterceptor struct {
+}
+
+func (n *OpenConnectionInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	lazy := getLazyInstanceInfo(invocation.CallerInstance())
+	if lazy == nil || atomic.LoadInt32(&lazy.state) != lazyInstanceUnresolved {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey, true)
+	invocation.SetContext(lazy)
+	return nil
+}
+
+func (n *OpenConnectionInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if invocation.GetContext() == nil {
+		return nil
+	}
+	info, _ := tracing.GetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey).(InstanceInfo)
+	connected := tracing.GetRuntimeContextValue(lazyConnectedRuntimeContextKey) == true
+	tracing.SetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey, nil)
+	tracing.SetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey, nil)
+	tracing.SetRuntimeContextValue(lazyConnectedRuntimeContextKey, nil)
+	if db, ok := invocation.CallerInstance().(*sql.DB); ok && db != nil {
+		invocation.GetContext().(*lazyInstanceInfo).resolve(db, info, connected)
+	}
+	return nil
+}
+
+// ConnectorConnectInterceptor marks the connection is opened by the connector of the database opened by the DSN
+type ConnectorConnectInterceptor struct {
+}
+
+func (n *ConnectorConnectInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *ConnectorConnectInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if tracing.GetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey) != true {
+		return nil
+	}
+	if err, ok := results[1].(error); ok && err != nil {
+		return nil
+	}
+	tracing.SetRuntimeContextValue(lazyConnectedRuntimeContextKey, true)
+	return nil
+}
@@ -0,0 +1,93 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package entry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyInstanceResolve(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	db := openTestDB(t, 1)
+	defer db.Close()
+	lazy := &lazyInstanceInfo{driverName: "lazy-resolve"}
+	// the connection is opened by another goroutine
+	lazy.resolve(db, nil, false)
+	assert.Equal(t, lazyInstanceUnresolved, lazy.state)
+
+	info := &tracing.SQLiteInfo{Path: "/data/app.db", Component: 5026}
+	lazy.resolve(db, info, true)
+	assert.Equal(t, lazyInstanceResolved, lazy.state)
+	assert.Equal(t, info, lazy.get())
+	assert.NotNil(t, poolMetricsMap["lazy-resolve//data/app.db"])
+
+	// resolved only once
+	lazy.resolve(db, &tracing.SQLiteInfo{Path: "/data/other.db"}, true)
+	assert.Equal(t, info, lazy.get())
+}
+
+func TestLazyInstanceUnknownDriver(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	db := openTestDB(t, 1)
+	defer db.Close()
+	lazy := &lazyInstanceInfo{driverName: "lazy-unknown"}
+	// the connection is opened in the current goroutine, but the driver does not provide the info
+	lazy.resolve(db, nil, true)
+	assert.Equal(t, lazyInstanceUnknown, lazy.state)
+	assert.Nil(t, lazy.get())
+	assert.NotNil(t, poolMetricsMap["lazy-unknown/"+unknownPeer])
+}
+
+func TestOpenConnectionInterceptor(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	db := openTestDB(t, 1)
+	defer db.Close()
+	lazy := &lazyInstanceInfo{driverName: "lazy-interceptor"}
+	invocation := operator.NewInvocation(db, nil)
+	invocation.SetContext(lazy)
+	tracing.SetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey, true)
+
+	connect := &ConnectorConnectInterceptor{}
+	assert.Nil(t, connect.AfterInvoke(operator.NewInvocation(nil, nil), nil, errors.New("unavailable")))
+	assert.Nil(t, tracing.GetRuntimeContextValue(lazyConnectedRuntimeContextKey), "the failed connection should not be marked")
+	assert.Nil(t, connect.AfterInvoke(operator.NewInvocation(nil, nil), nil, nil))
+	assert.Equal(t, true, tracing.GetRuntimeContextValue(lazyConnectedRuntimeContextKey))
+
+	assert.Nil(t, (&OpenConnectionInterceptor{}).AfterInvoke(invocation, nil, nil))
+	assert.Equal(t, lazyInstanceUnknown, lazy.state)
+	for _, key := range []string{tracing.SQLNeedInfoRuntimeContextKey, tracing.SQLInfoRuntimeContextKey, lazyConnectedRuntimeContextKey} {
+		assert.Nil(t, tracing.GetRuntimeContextValue(key), "the runtime context %s should be cleaned", key)
+	}
+
+	// the connection opened without the lazy instance should not be marked
+	assert.Nil(t, connect.AfterInvoke(operator.NewInvocation(nil, nil), nil, nil))
+	assert.Nil(t, tracing.GetRuntimeContextValue(lazyConnectedRuntimeContextKey))
+}
@@ -33,6 +33,11 @@ type InstanceInfo interface {
 	DBType() string
 }
 
+// InstanceDatabase is optional for the InstanceInfo, which provides the database name as the db.instance tag
+type InstanceDatabase interface {
+	Database() string
+}
+
 func (n *InstanceInterceptor) BeforeInvoke(invocation operator.Invocation) error {
 	tracing.SetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey, true)
 	return nil
@@ -40,16 +45,29 @@ func (n *InstanceInterceptor) BeforeInvoke(invocation operator.Invocation) error
 
 func (n *InstanceInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
 	tracing.SetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey, nil)
-	info, ok := tracing.GetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey).(InstanceInfo)
+	info, hasInfo := tracing.GetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey).(InstanceInfo)
 	tracing.SetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey, nil)
 	tracing.SetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey, nil)
-	if !ok || info == nil {
+	db, isDB := results[0].(*sql.DB)
+	if !isDB || db == nil {
+		return nil
+	}
+	driverName, ok := invocation.Args()[0].(string)
+	if !ok {
 		return nil
 	}
 
-	// adding peer address into db
-	if db, ok := results[0].(*sql.DB); ok && db != nil {
-		results[0].(operator.EnhancedInstance).SetSkyWalkingDynamicField(info)
+	// the driver does not parse the DSN when opening the database(such as lib/pq and SQLite),
+	// so the instance info is resolved when the first connection is opened
+	if !hasInfo || info == nil {
+		results[0].(operator.EnhancedInstance).SetSkyWalkingDynamicField(&lazyInstanceInfo{
+			driverName: driverName,
+		})
+		return nil
 	}
+
+	// register the connection pool metrics and adding peer address into db
+	registerPoolMetrics(db, driverName, info.Peer())
+	results[0].(operator.EnhancedInstance).SetSkyWalkingDynamicField(info)
 	return nil
 }
@@ -72,6 +72,27 @@ func (i *Instrument) Points() []*instrument.Point {
 				instrument.WithResultCount(2), instrument.WithResultType(0, "*DB"), instrument.WithResultType(1, "error")),
 			Interceptor: "InstanceInterceptor",
 		},
+		{
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*DB", "conn",
+				instrument.WithArgsCount(2), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "connReuseStrategy"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "*driverConn"), instrument.WithResultType(1, "error")),
+			Interceptor: "OpenConnectionInterceptor",
+		},
+		{
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*DB", "openNewConnection",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "context.Context"),
+				instrument.WithResultCount(0)),
+			Interceptor: "OpenConnectionInterceptor",
+		},
+		{
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("dsnConnector", "Connect",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "context.Context"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "driver.Conn"), instrument.WithResultType(1, "error")),
+			Interceptor: "ConnectorConnectInterceptor",
+		},
 		{
 			PackagePath: "",
 			At: instrument.NewMethodEnhance("*DB", "PingContext",
@@ -114,6 +135,13 @@ func (i *Instrument) Points() []*instrument.Point {
 				instrument.WithResultCount(2), instrument.WithResultType(0, "*Conn"), instrument.WithResultType(1, "error")),
 			Interceptor: "ConnInterceptor",
 		},
+		{
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*DB", "Close",
+				instrument.WithArgsCount(0),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "error")),
+			Interceptor: "CloseInterceptor",
+		},
 		// Conn operation
 		{
 			PackagePath: "",
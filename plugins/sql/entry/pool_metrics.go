@@ -0,0 +1,126 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package entry
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/apache/skywalking-go/plugins/core/metrics"
+)
+
+const unknownPeer = "unknown"
+
+var (
+	poolMetricsLock sync.Mutex
+	poolMetricsMap  = make(map[string]*poolMetrics)
+)
+
+// poolMetrics reports the connection pool stats of the databases,
+// the databases with the same driver and peer are aggregated into the same meters
+type poolMetrics struct {
+	dbs   []*sql.DB
+	stats sql.DBStats
+}
+
+func registerPoolMetrics(db *sql.DB, driverName, peer string) {
+	poolMetricsLock.Lock()
+	defer poolMetricsLock.Unlock()
+	key := driverName + "/" + peer
+	if p, exist := poolMetricsMap[key]; exist {
+		p.dbs = append(p.dbs, db)
+		return
+	}
+	p := &poolMetrics{dbs: []*sql.DB{db}}
+	poolMetricsMap[key] = p
+
+	opts := []metrics.Opt{metrics.WithLabel("peer", peer), metrics.WithLabel("driver", driverName)}
+	p.gauge("instance_golang_sql_pool_max_open_connections", func(s *sql.DBStats) float64 {
+		return float64(s.MaxOpenConnections)
+	}, opts)
+	p.gauge("instance_golang_sql_pool_open_connections", func(s *sql.DBStats) float64 {
+		return float64(s.OpenConnections)
+	}, opts)
+	p.gauge("instance_golang_sql_pool_in_use_connections", func(s *sql.DBStats) float64 {
+		return float64(s.InUse)
+	}, opts)
+	p.gauge("instance_golang_sql_pool_idle_connections", func(s *sql.DBStats) float64 {
+		return float64(s.Idle)
+	}, opts)
+	p.gauge("instance_golang_sql_pool_wait_count", func(s *sql.DBStats) float64 {
+		return float64(s.WaitCount)
+	}, opts)
+	p.gauge("instance_golang_sql_pool_wait_duration", func(s *sql.DBStats) float64 {
+		return float64(s.WaitDuration.Milliseconds())
+	}, opts)
+	p.gauge("instance_golang_sql_pool_max_idle_closed", func(s *sql.DBStats) float64 {
+		return float64(s.MaxIdleClosed)
+	}, opts)
+	p.gauge("instance_golang_sql_pool_max_idle_time_closed", func(s *sql.DBStats) float64 {
+		return float64(s.MaxIdleTimeClosed)
+	}, opts)
+	p.gauge("instance_golang_sql_pool_max_lifetime_closed", func(s *sql.DBStats) float64 {
+		return float64(s.MaxLifetimeClosed)
+	}, opts)
+	metrics.RegisterBeforeCollectHook(p.collect)
+}
+
+// unregisterPoolMetrics removes the closed database from the pool metrics,
+// the meters are kept and report the stats of the remaining databases
+func unregisterPoolMetrics(db *sql.DB) {
+	poolMetricsLock.Lock()
+	defer poolMetricsLock.Unlock()
+	for _, p := range poolMetricsMap {
+		for i, d := range p.dbs {
+			if d != db {
+				continue
+			}
+			dbs := make([]*sql.DB, 0, len(p.dbs)-1)
+			dbs = append(dbs, p.dbs[:i]...)
+			p.dbs = append(dbs, p.dbs[i+1:]...)
+			return
+		}
+	}
+}
+
+func (p *poolMetrics) gauge(name string, getter func(s *sql.DBStats) float64, opts []metrics.Opt) {
+	metrics.NewGauge(name, func() float64 {
+		return getter(&p.stats)
+	}, opts...)
+}
+
+func (p *poolMetrics) collect() {
+	poolMetricsLock.Lock()
+	dbs := p.dbs
+	poolMetricsLock.Unlock()
+
+	result := sql.DBStats{}
+	for _, db := range dbs {
+		stats := db.Stats()
+		result.MaxOpenConnections += stats.MaxOpenConnections
+		result.OpenConnections += stats.OpenConnections
+		result.InUse += stats.InUse
+		result.Idle += stats.Idle
+		result.WaitCount += stats.WaitCount
+		result.WaitDuration += stats.WaitDuration
+		result.MaxIdleClosed += stats.MaxIdleClosed
+		result.MaxIdleTimeClosed += stats.MaxIdleTimeClosed
+		result.MaxLifetimeClosed += stats.MaxLifetimeClosed
+	}
+	p.stats = result
+}
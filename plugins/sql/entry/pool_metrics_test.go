@@ -0,0 +1,107 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package entry
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type unavailableDriver struct {
+}
+
+func (d *unavailableDriver) Open(name string) (driver.Conn, error) {
+	return nil, errors.New("unavailable")
+}
+
+func init() {
+	sql.Register("pool-metrics-test", &unavailableDriver{})
+}
+
+func openTestDB(t *testing.T, maxOpen int) *sql.DB {
+	db, err := sql.Open("pool-metrics-test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(maxOpen)
+	return db
+}
+
+func TestPoolMetricsAggregated(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	db1, db2 := openTestDB(t, 3), openTestDB(t, 4)
+	defer db1.Close()
+	defer db2.Close()
+	registerPoolMetrics(db1, "aggregated", "db:3306")
+	registerPoolMetrics(db2, "aggregated", "db:3306")
+
+	p := poolMetricsMap["aggregated/db:3306"]
+	if !assert.NotNil(t, p) {
+		return
+	}
+	p.collect()
+	assert.Equal(t, 7, p.stats.MaxOpenConnections, "the databases with the same peer should be aggregated")
+	assert.Equal(t, 0, p.stats.OpenConnections)
+}
+
+func TestPoolMetricsUnregisterWhenClosed(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	db1, db2 := openTestDB(t, 3), openTestDB(t, 4)
+	defer db2.Close()
+	registerPoolMetrics(db1, "closing", "db:3306")
+	registerPoolMetrics(db2, "closing", "db:3306")
+
+	interceptor := &CloseInterceptor{}
+	assert.Nil(t, interceptor.BeforeInvoke(operator.NewInvocation(db1)))
+	assert.Nil(t, db1.Close())
+	assert.Nil(t, interceptor.AfterInvoke(operator.NewInvocation(db1), nil))
+
+	p := poolMetricsMap["closing/db:3306"]
+	assert.Equal(t, []*sql.DB{db2}, p.dbs)
+	p.collect()
+	assert.Equal(t, 4, p.stats.MaxOpenConnections, "the closed database should not be reported")
+
+	// closing the unknown database should be ignored
+	assert.Nil(t, interceptor.AfterInvoke(operator.NewInvocation(openTestDB(t, 1)), nil))
+	assert.Len(t, p.dbs, 1)
+}
+
+func TestInstanceInterceptorIgnoreInvalidDriverName(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	db := openTestDB(t, 1)
+	defer db.Close()
+	interceptor := &InstanceInterceptor{}
+	invocation := operator.NewInvocation(nil, 1, "")
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.NotPanics(t, func() {
+		assert.Nil(t, interceptor.AfterInvoke(invocation, db, nil))
+	})
+}
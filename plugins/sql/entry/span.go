@@ -30,9 +30,7 @@ func createExitSpan(caller interface{}, method string, opts ...tracing.SpanOptio
 
 	span, err := tracing.CreateExitSpan(info.DBType()+"/"+method, info.Peer(), func(headerKey, headerValue string) error {
 		return nil
-	}, append(opts, tracing.WithComponent(info.ComponentID()),
-		tracing.WithLayer(tracing.SpanLayerDatabase),
-		tracing.WithTag(tracing.TagDBType, info.DBType()))...)
+	}, appendInstanceOpts(opts, info)...)
 	return span, err
 }
 
@@ -42,13 +40,20 @@ func createLocalSpan(caller interface{}, method string, opts ...tracing.SpanOpti
 		return nil, nil, nil
 	}
 
-	span, err := tracing.CreateLocalSpan(info.DBType()+"/"+method,
-		append(opts, tracing.WithComponent(info.ComponentID()),
-			tracing.WithLayer(tracing.SpanLayerDatabase),
-			tracing.WithTag(tracing.TagDBType, info.DBType()))...)
+	span, err := tracing.CreateLocalSpan(info.DBType()+"/"+method, appendInstanceOpts(opts, info)...)
 	return span, info, err
 }
 
+func appendInstanceOpts(opts []tracing.SpanOption, info InstanceInfo) []tracing.SpanOption {
+	opts = append(opts, tracing.WithComponent(info.ComponentID()),
+		tracing.WithLayer(tracing.SpanLayerDatabase),
+		tracing.WithTag(tracing.TagDBType, info.DBType()))
+	if database, ok := info.(InstanceDatabase); ok && database.Database() != "" {
+		opts = append(opts, tracing.WithTag(tracing.TagDBInstance, database.Database()))
+	}
+	return opts
+}
+
 func getInstanceInfo(caller interface{}) InstanceInfo {
 	instance, ok := caller.(operator.EnhancedInstance)
 	if !ok || instance == nil {
@@ -58,6 +63,9 @@ func getInstanceInfo(caller interface{}) InstanceInfo {
 	if df == nil {
 		return nil
 	}
+	if lazy, ok := df.(*lazyInstanceInfo); ok {
+		return lazy.get()
+	}
 	info, ok := df.(InstanceInfo)
 	if !ok {
 		return nil
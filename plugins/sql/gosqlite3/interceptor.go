@@ -0,0 +1,43 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gosqlite3
+
+import (
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+// sqliteComponentID is the component ID of the SQLite drivers
+const sqliteComponentID int32 = 5026
+
+type OpenInterceptor struct {
+}
+
+func (i *OpenInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (i *OpenInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if tracing.GetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey) != true {
+		return nil
+	}
+	if dsn, ok := invocation.Args()[0].(string); ok {
+		tracing.SetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey, tracing.ParseSQLiteDSN(dsn, sqliteComponentID))
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gosqlite3
+
+import (
+	"testing"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenInterceptor(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	tracing.SetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey, true)
+	err := (&OpenInterceptor{}).AfterInvoke(operator.NewInvocation(nil, "file:/data/app.db?cache=shared"), nil, nil)
+	assert.Nil(t, err)
+
+	info, ok := tracing.GetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey).(*tracing.SQLiteInfo)
+	assert.True(t, ok)
+	assert.Equal(t, "/data/app.db", info.Peer())
+	assert.Equal(t, "app.db", info.Database())
+	assert.Equal(t, int32(5026), info.ComponentID())
+	assert.Equal(t, "SQLite", info.DBType())
+}
@@ -0,0 +1,41 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pgx
+
+import (
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+// BatchQueueInterceptor records the queued queries into the batch,
+// the queued queries field of the batch is not exported in the early versions.
+type BatchQueueInterceptor struct {
+}
+
+func (n *BatchQueueInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *BatchQueueInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	batch, ok := invocation.CallerInstance().(operator.EnhancedInstance)
+	if !ok || batch == nil {
+		return nil
+	}
+	queries, _ := batch.GetSkyWalkingDynamicField().([]string)
+	batch.SetSkyWalkingDynamicField(append(queries, invocation.Args()[0].(string)))
+	return nil
+}
@@ -0,0 +1,47 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pgx
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+type CopyFromInterceptor struct {
+}
+
+func (n *CopyFromInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	statement := "COPY " + invocation.Args()[1].(pgx.Identifier).Sanitize()
+	if columns := invocation.Args()[2].([]string); len(columns) > 0 {
+		statement += " (" + strings.Join(columns, ", ") + ")"
+	}
+	span, err := createExitSpan(invocation.CallerInstance(), "CopyFrom", statement+" FROM STDIN", nil)
+	if err != nil || span == nil {
+		return err
+	}
+	invocation.SetContext(span)
+	return nil
+}
+
+func (n *CopyFromInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	endSpan(invocation, results[1])
+	return nil
+}
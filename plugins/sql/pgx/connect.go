@@ -0,0 +1,73 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pgx
+
+import (
+	"github.com/jackc/pgx/v5"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+type ConnectInterceptor struct {
+}
+
+type DBInfo struct {
+	Peer     string
+	Database string
+}
+
+func (n *ConnectInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (n *ConnectInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if err, ok := results[1].(error); ok && err != nil {
+		return nil
+	}
+	conn, ok := results[0].(*pgx.Conn)
+	if !ok || conn == nil {
+		return nil
+	}
+	instance, ok := interface{}(conn).(operator.EnhancedInstance)
+	if !ok {
+		return nil
+	}
+	if info := buildDBInfo(invocation.Args()[1].(*pgx.ConnConfig)); info != nil {
+		instance.SetSkyWalkingDynamicField(info)
+	}
+	return nil
+}
+
+func buildDBInfo(cfg *pgx.ConnConfig) *DBInfo {
+	if cfg == nil {
+		return nil
+	}
+	fallbacks := make([]tracing.PostgreSQLAddress, 0, len(cfg.Fallbacks))
+	for _, fallback := range cfg.Fallbacks {
+		if fallback == nil {
+			continue
+		}
+		fallbacks = append(fallbacks, tracing.PostgreSQLAddress{Host: fallback.Host, Port: fallback.Port})
+	}
+	peer := tracing.BuildPostgreSQLPeer(tracing.PostgreSQLAddress{Host: cfg.Host, Port: cfg.Port}, fallbacks)
+	if peer == "" {
+		return nil
+	}
+	return &DBInfo{Peer: peer, Database: cfg.Database}
+}
@@ -0,0 +1,127 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pgx
+
+import (
+	"embed"
+
+	"github.com/apache/skywalking-go/plugins/core/instrument"
+)
+
+//go:embed *
+var fs embed.FS
+
+//skywalking:nocopy
+type Instrument struct {
+}
+
+func NewInstrument() *Instrument {
+	return &Instrument{}
+}
+
+func (i *Instrument) Name() string {
+	return "sql"
+}
+
+func (i *Instrument) BasePackage() string {
+	return "github.com/jackc/pgx/v5"
+}
+
+func (i *Instrument) VersionChecker(version string) bool {
+	return true
+}
+
+func (i *Instrument) Points() []*instrument.Point {
+	return []*instrument.Point{
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At:          instrument.NewStructEnhance("Conn"),
+		},
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At:          instrument.NewStructEnhance("Batch"),
+		},
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At: instrument.NewStaticMethodEnhance("connect",
+				instrument.WithArgsCount(2), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "*ConnConfig"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "*Conn"), instrument.WithResultType(1, "error")),
+			Interceptor: "ConnectInterceptor",
+		},
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*Conn", "Exec",
+				instrument.WithArgsCount(3), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "pgconn.CommandTag"), instrument.WithResultType(1, "error")),
+			Interceptor: "ExecInterceptor",
+		},
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*Conn", "Query",
+				instrument.WithArgsCount(3), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "Rows"), instrument.WithResultType(1, "error")),
+			Interceptor: "QueryInterceptor",
+		},
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*Conn", "QueryRow",
+				instrument.WithArgsCount(3), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "string"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "Row")),
+			Interceptor: "QueryRowInterceptor",
+		},
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*Conn", "SendBatch",
+				instrument.WithArgsCount(2), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "*Batch"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "BatchResults")),
+			Interceptor: "SendBatchInterceptor",
+		},
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*Conn", "CopyFrom",
+				instrument.WithArgsCount(4), instrument.WithArgType(0, "context.Context"), instrument.WithArgType(1, "Identifier"),
+				instrument.WithArgType(2, "[]string"), instrument.WithArgType(3, "CopyFromSource"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "int64"), instrument.WithResultType(1, "error")),
+			Interceptor: "CopyFromInterceptor",
+		},
+		{
+			PackageName: "pgx",
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*Batch", "Queue",
+				instrument.WithArgsCount(2), instrument.WithArgType(0, "string"),
+				instrument.WithResultCount(1), instrument.WithResultType(0, "*QueuedQuery")),
+			Interceptor: "BatchQueueInterceptor",
+		},
+	}
+}
+
+func (i *Instrument) PluginSourceCodePath() string {
+	return "pgx"
+}
+
+func (i *Instrument) FS() *embed.FS {
+	return &fs
+}
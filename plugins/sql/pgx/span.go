@@ -0,0 +1,91 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pgx
+
+import (
+	"fmt"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const postgreSQLComponentID int32 = 22
+
+// createExitSpan creates the exit span for the connection which is connected by the native pgx API.
+// all tags are passed as options, so nothing is changed when the span of the database/sql plugin is reused.
+func createExitSpan(caller interface{}, method, statement string, args []interface{}) (tracing.Span, error) {
+	info := getDBInfo(caller)
+	if info == nil {
+		return nil, nil
+	}
+	opts := []tracing.SpanOption{
+		tracing.WithComponent(postgreSQLComponentID),
+		tracing.WithLayer(tracing.SpanLayerDatabase),
+		tracing.WithTag(tracing.TagDBType, "PostgreSQL"),
+	}
+	if info.Database != "" {
+		opts = append(opts, tracing.WithTag(tracing.TagDBInstance, info.Database))
+	}
+	if statement != "" {
+		opts = append(opts, tracing.WithTag(tracing.TagDBStatement, statement))
+	}
+	if config.CollectParameter && len(args) > 0 {
+		opts = append(opts, tracing.WithTag(tracing.TagDBSqlParameters, argsToString(args)))
+	}
+	return tracing.CreateExitSpan("PostgreSQL/"+method, info.Peer, func(headerKey, headerValue string) error {
+		return nil
+	}, opts...)
+}
+
+func getDBInfo(caller interface{}) *DBInfo {
+	instance, ok := caller.(operator.EnhancedInstance)
+	if !ok || instance == nil {
+		return nil
+	}
+	info, ok := instance.GetSkyWalkingDynamicField().(*DBInfo)
+	if !ok {
+		return nil
+	}
+	return info
+}
+
+func endSpan(invocation operator.Invocation, err interface{}) {
+	span, ok := invocation.GetContext().(tracing.Span)
+	if !ok || span == nil {
+		return
+	}
+	if e, ok := err.(error); ok && e != nil {
+		span.Error(e.Error())
+	}
+	span.End()
+}
+
+func argsToString(args []interface{}) string {
+	switch len(args) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("%v", args[0])
+	}
+
+	res := fmt.Sprintf("%v", args[0])
+	for _, arg := range args[1:] {
+		res += fmt.Sprintf(", %v", arg)
+	}
+	return res
+}
@@ -0,0 +1,44 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pgxstdlib
+
+import (
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+)
+
+// ConnectInterceptor leaves the connection opened by database/sql to the sql plugin,
+// otherwise the native pgx spans would be created inside the transaction spans.
+type ConnectInterceptor struct {
+}
+
+func (i *ConnectInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (i *ConnectInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	conn, ok := results[0].(*stdlib.Conn)
+	if !ok || conn == nil {
+		return nil
+	}
+	if instance, ok := interface{}(conn.Conn()).(operator.EnhancedInstance); ok {
+		instance.SetSkyWalkingDynamicField(nil)
+	}
+	return nil
+}
@@ -55,6 +55,20 @@ func (i *Instrument) Points() []*instrument.Point {
 				instrument.WithResultCount(2), instrument.WithResultType(0, "driver.Connector"), instrument.WithResultType(1, "error")),
 			Interceptor: "OpenConnectorInterceptor",
 		},
+		{
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("connector", "Connect",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "context.Context"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "driver.Conn"), instrument.WithResultType(1, "error")),
+			Interceptor: "ConnectInterceptor",
+		},
+		{
+			PackagePath: "",
+			At: instrument.NewMethodEnhance("*driverConnector", "Connect",
+				instrument.WithArgsCount(1), instrument.WithArgType(0, "context.Context"),
+				instrument.WithResultCount(2), instrument.WithResultType(0, "driver.Conn"), instrument.WithResultType(1, "error")),
+			Interceptor: "ConnectInterceptor",
+		},
 	}
 }
 
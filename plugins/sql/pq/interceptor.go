@@ -0,0 +1,229 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pq
+
+import (
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+)
+
+const (
+	postgreSQLComponentID int32 = 22
+	defaultPostgreSQLHost       = "localhost"
+	defaultPostgreSQLPort       = 5432
+)
+
+type OpenInterceptor struct {
+}
+
+func (i *OpenInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	return nil
+}
+
+func (i *OpenInterceptor) AfterInvoke(invocation operator.Invocation, results ...interface{}) error {
+	if tracing.GetRuntimeContextValue(tracing.SQLNeedInfoRuntimeContextKey) != true {
+		return nil
+	}
+	dsn, ok := invocation.Args()[0].(string)
+	if !ok {
+		return nil
+	}
+	if info := parseDSN(dsn); info != nil {
+		tracing.SetRuntimeContextValue(tracing.SQLInfoRuntimeContextKey, info)
+	}
+	return nil
+}
+
+type DBInfo struct {
+	PeerAddress  string
+	DatabaseName string
+}
+
+func (i *DBInfo) Peer() string {
+	return i.PeerAddress
+}
+
+func (i *DBInfo) ComponentID() int32 {
+	return postgreSQLComponentID
+}
+
+func (i *DBInfo) DBType() string {
+	return "PostgreSQL"
+}
+
+func (i *DBInfo) Database() string {
+	return i.DatabaseName
+}
+
+// parseDSN parses the URL or key/value connection string like lib/pq,
+// the environment variables are used when the option is not in the connection string
+func parseDSN(dsn string) *DBInfo {
+	opts := map[string]string{}
+	for key, env := range map[string]string{"host": "PGHOST", "hostaddr": "PGHOSTADDR", "port": "PGPORT",
+		"dbname": "PGDATABASE", "user": "PGUSER"} {
+		if val := os.Getenv(env); val != "" {
+			opts[key] = val
+		}
+	}
+	var ok bool
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		ok = parseURLOptions(dsn, opts)
+	} else {
+		ok = parseKeyValueOptions(dsn, opts)
+	}
+	if !ok {
+		return nil
+	}
+
+	addresses, ok := buildAddresses(opts)
+	if !ok {
+		return nil
+	}
+	database := opts["dbname"]
+	if database == "" {
+		database = opts["user"]
+	}
+	return &DBInfo{
+		PeerAddress:  tracing.BuildPostgreSQLPeer(addresses[0], addresses[1:]),
+		DatabaseName: database,
+	}
+}
+
+func parseURLOptions(dsn string, opts map[string]string) bool {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return false
+	}
+	if u.User != nil && u.User.Username() != "" {
+		opts["user"] = u.User.Username()
+	}
+	if u.Hostname() != "" {
+		opts["host"] = u.Hostname()
+	}
+	if u.Port() != "" {
+		opts["port"] = u.Port()
+	}
+	if database := strings.TrimPrefix(u.Path, "/"); database != "" {
+		opts["dbname"] = database
+	}
+	for key, values := range u.Query() {
+		if len(values) > 0 {
+			opts[key] = values[0]
+		}
+	}
+	return true
+}
+
+func parseKeyValueOptions(dsn string, opts map[string]string) bool {
+	pos := 0
+	for {
+		pos = skipDSNSpaces(dsn, pos)
+		if pos >= len(dsn) {
+			return true
+		}
+		start := pos
+		for pos < len(dsn) && dsn[pos] != '=' && dsn[pos] != ' ' {
+			pos++
+		}
+		key := dsn[start:pos]
+		pos = skipDSNSpaces(dsn, pos)
+		if pos >= len(dsn) || dsn[pos] != '=' || key == "" {
+			return false
+		}
+		pos++
+		pos = skipDSNSpaces(dsn, pos)
+
+		value := make([]byte, 0)
+		quoted := pos < len(dsn) && dsn[pos] == '\''
+		if quoted {
+			pos++
+		}
+		for ; pos < len(dsn); pos++ {
+			c := dsn[pos]
+			if quoted && c == '\'' {
+				break
+			}
+			if !quoted && c == ' ' {
+				break
+			}
+			if c == '\\' && pos+1 < len(dsn) {
+				pos++
+				c = dsn[pos]
+			}
+			value = append(value, c)
+		}
+		if quoted {
+			if pos >= len(dsn) {
+				return false
+			}
+			pos++
+		}
+		opts[key] = string(value)
+	}
+}
+
+func skipDSNSpaces(dsn string, pos int) int {
+	for pos < len(dsn) && (dsn[pos] == ' ' || dsn[pos] == '\t' || dsn[pos] == '\n' || dsn[pos] == '\r') {
+		pos++
+	}
+	return pos
+}
+
+func buildAddresses(opts map[string]string) ([]tracing.PostgreSQLAddress, bool) {
+	hosts := strings.Split(opts["host"], ",")
+	if addrs := opts["hostaddr"]; addrs != "" {
+		for i, addr := range strings.Split(addrs, ",") {
+			if addr == "" {
+				continue
+			}
+			if i < len(hosts) {
+				hosts[i] = addr
+			} else {
+				hosts = append(hosts, addr)
+			}
+		}
+	}
+	ports := strings.Split(opts["port"], ",")
+	addresses := make([]tracing.PostgreSQLAddress, 0, len(hosts))
+	for i, host := range hosts {
+		if host == "" {
+			host = defaultPostgreSQLHost
+		}
+		port := ""
+		if len(ports) == 1 {
+			port = ports[0]
+		} else if i < len(ports) {
+			port = ports[i]
+		}
+		address := tracing.PostgreSQLAddress{Host: host, Port: defaultPostgreSQLPort}
+		if port != "" {
+			val, err := strconv.ParseUint(port, 10, 16)
+			if err != nil {
+				return nil, false
+			}
+			address.Port = uint16(val)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, true
+}
@@ -2,4 +2,13 @@ module github.com/apache/skywalking-go/plugin/trace
 
 go 1.24
 
-require github.com/dave/dst v0.27.2 // indirect
+require (
+	github.com/dave/dst v0.27.2
+	github.com/stretchr/testify v1.9.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -213,21 +213,25 @@ func tracePoint() []*instrument.Point {
 func loggingPoint() []*instrument.Point {
 	return []*instrument.Point{
 		{
-			PackagePath: "logging", At: instrument.NewStaticMethodEnhance("Debug"),
+			PackagePath: "logging", At: instrument.NewStaticMethodEnhance("Debug", instrument.WithStaticMethod()),
 			Interceptor: "DebugEntryInterceptor",
 		},
 		{
-			PackagePath: "logging", At: instrument.NewStaticMethodEnhance("Info"),
+			PackagePath: "logging", At: instrument.NewStaticMethodEnhance("Info", instrument.WithStaticMethod()),
 			Interceptor: "InfoEntryInterceptor",
 		},
 		{
-			PackagePath: "logging", At: instrument.NewStaticMethodEnhance("Warn"),
+			PackagePath: "logging", At: instrument.NewStaticMethodEnhance("Warn", instrument.WithStaticMethod()),
 			Interceptor: "WarnEntryInterceptor",
 		},
 		{
-			PackagePath: "logging", At: instrument.NewStaticMethodEnhance("Error"),
+			PackagePath: "logging", At: instrument.NewStaticMethodEnhance("Error", instrument.WithStaticMethod()),
 			Interceptor: "ErrorEntryInterceptor",
 		},
+		{
+			PackagePath: "logging", At: instrument.NewMethodEnhance("*Logger", "Log"),
+			Interceptor: "LoggerLogInterceptor",
+		},
 	}
 }
 
@@ -0,0 +1,69 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package traceactivation
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/decorator"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggingStaticMethodPoints(t *testing.T) {
+	// the level functions of the package have the same names as the methods of the Logger
+	dir := filepath.Join("..", "..", "toolkit", "logging")
+	entries, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	files := make([]*dst.File, 0)
+	for _, entry := range entries {
+		f, err := decorator.ParseFile(token.NewFileSet(), filepath.Join(dir, entry.Name()), nil, parser.ParseComments)
+		assert.Nil(t, err)
+		files = append(files, f)
+	}
+
+	for _, point := range loggingPoint() {
+		if point.At.Receiver != "" {
+			continue
+		}
+		matched := make([]*dst.FuncDecl, 0)
+		for _, f := range files {
+			for _, decl := range f.Decls {
+				fun, ok := decl.(*dst.FuncDecl)
+				if !ok || fun.Name.Name != point.At.Name {
+					continue
+				}
+				passed := true
+				for _, filter := range point.At.MethodFilters {
+					passed = passed && filter(fun, files)
+				}
+				if passed {
+					matched = append(matched, fun)
+				}
+			}
+		}
+		if assert.Len(t, matched, 1, point.At.Name) {
+			assert.Nil(t, matched[0].Recv, point.At.Name)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logging
+
+import (
+	"context"
+
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+	"github.com/apache/skywalking-go/toolkit/logging"
+)
+
+// the framework name of the toolkit logs in the "log.reporter.json_body_frameworks"
+const toolkitLogFramework = "toolkit"
+
+type LoggerLogInterceptor struct{}
+
+func (h *LoggerLogInterceptor) BeforeInvoke(invocation operator.Invocation) error {
+	logger, ok := invocation.CallerInstance().(*logging.Logger)
+	if !ok || logger == nil {
+		return nil
+	}
+	ctx, _ := invocation.Args()[0].(context.Context)
+	level, _ := invocation.Args()[1].(logging.Level)
+	msg, _ := invocation.Args()[2].(string)
+	attrs, _ := invocation.Args()[3].([]logging.Attr)
+
+	fields := make([]logging.Attr, 0, len(logger.Fields())+len(attrs))
+	fields = append(fields, logger.Fields()...)
+	fields = append(fields, attrs...)
+	snapshot := logging.SnapshotFromContext(ctx)
+	sendStructuredLogEntry(snapshot, string(level), msg, fields)
+	// the span in the snapshot may be still running in another goroutine, so only record on the active span
+	if logger.SpanLogEnabled() && snapshot == nil {
+		recordSpanLog(string(level), msg, fields)
+	}
+	return nil
+}
+
+func (h *LoggerLogInterceptor) AfterInvoke(_ operator.Invocation, _ ...interface{}) error {
+	return nil
+}
+
+func sendStructuredLogEntry(snapshot interface{}, level, msg string, attrs []logging.Attr) {
+	logReporter, ok := operator.GetOperator().LogReporter().(operator.LogReporter)
+	if !ok || logReporter == nil {
+		return
+	}
+	keys := logReporter.GetLogLabelKeys()
+	labels := make(map[string]string, len(keys))
+	for _, key := range keys {
+		for _, attr := range attrs {
+			if attr.Key == key {
+				labels[key] = attr.ValueString()
+			}
+		}
+	}
+	var logContext interface{}
+	if snapshot != nil {
+		logContext = logReporter.GetLogContextBySnapshot(snapshot, true)
+	} else {
+		logContext = logReporter.GetLogContext(true)
+	}
+	if !logReporter.IsLogJSONBody(toolkitLogFramework) {
+		logReporter.ReportLog(logContext, nil, level, msg, labels)
+		return
+	}
+	fields := make(map[string]interface{}, len(attrs))
+	for _, attr := range attrs {
+		fields[attr.Key] = attr.Value
+	}
+	logReporter.ReportStructuredLog(logContext, nil, level, msg, labels, fields)
+}
+
+func recordSpanLog(level, msg string, attrs []logging.Attr) {
+	span := tracing.ActiveSpan()
+	if span == nil {
+		return
+	}
+	logs := make([]string, 0, 4+len(attrs)*2)
+	logs = append(logs, "event", level, "message", msg)
+	for _, attr := range attrs {
+		logs = append(logs, attr.Key, attr.ValueString())
+	}
+	span.Log(logs...)
+}
@@ -0,0 +1,176 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/apache/skywalking-go/plugins/core"
+	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/plugins/core/tracing"
+	"github.com/apache/skywalking-go/toolkit/logging"
+
+	logv3 "github.com/apache/skywalking-go/protocols/collect/logging/v3"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func logTags(log *logv3.LogData) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range log.GetTags().GetData() {
+		tags[tag.Key] = tag.Value
+	}
+	return tags
+}
+
+func invokeLoggerLog(t *testing.T, logger *logging.Logger, ctx context.Context, level logging.Level, msg string, attrs ...logging.Attr) {
+	interceptor := &LoggerLogInterceptor{}
+	invocation := operator.NewInvocation(logger, ctx, level, msg, attrs)
+	assert.Nil(t, interceptor.BeforeInvoke(invocation))
+	assert.Nil(t, interceptor.AfterInvoke(invocation))
+}
+
+func TestLoggerLogWithTextBody(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+	core.SetLogReporterConfig([]string{"user", "missing"}, nil)
+
+	span, err := tracing.CreateLocalSpan("test")
+	assert.Nil(t, err)
+	logger := logging.WithFields(logging.String("user", "u1"))
+	invokeLoggerLog(t, logger, context.Background(), logging.WarnLevel, "test message", logging.Int("count", 1))
+	span.End()
+
+	logs := core.GetReportedLogs()
+	if !assert.Len(t, logs, 1) {
+		return
+	}
+	assert.Equal(t, "test message", logs[0].GetBody().GetText().GetText())
+	assert.Equal(t, map[string]string{"LEVEL": "warn", "user": "u1"}, logTags(logs[0]), "only the label keys should be reported")
+	assert.Equal(t, span.TraceID(), logs[0].GetTraceContext().GetTraceId())
+	assert.Equal(t, span.SpanID(), logs[0].GetTraceContext().GetSpanId())
+}
+
+func TestLoggerLogWithJSONBody(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+	core.SetLogReporterConfig([]string{"count"}, []string{"zap", toolkitLogFramework})
+
+	span, err := tracing.CreateLocalSpan("test")
+	assert.Nil(t, err)
+	logger := logging.WithFields(logging.String("user", "u1"))
+	invokeLoggerLog(t, logger, context.Background(), logging.InfoLevel, "test message",
+		logging.Int("count", 1), logging.Bool("ok", true), logging.Duration("cost", time.Second))
+	span.End()
+
+	logs := core.GetReportedLogs()
+	if !assert.Len(t, logs, 1) {
+		return
+	}
+	body := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal([]byte(logs[0].GetBody().GetJson().GetJson()), &body))
+	assert.Equal(t, map[string]interface{}{
+		"message": "test message", "user": "u1", "count": float64(1), "ok": true, "cost": float64(time.Second),
+	}, body)
+	assert.Equal(t, map[string]string{"LEVEL": "info", "count": "1"}, logTags(logs[0]))
+}
+
+func TestLoggerLogWithSnapshot(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	span, err := tracing.CreateLocalSpan("captured")
+	assert.Nil(t, err)
+	snapshot := tracing.CaptureContext()
+	// the log is written in another goroutine which has no active span
+	tracing.CleanContext()
+	logger := logging.WithFields().WithSpanLog()
+	ctx := logging.ContextWithSnapshot(context.Background(), snapshot)
+	invokeLoggerLog(t, logger, ctx, logging.ErrorLevel, "snapshot message")
+	tracing.ContinueContext(snapshot)
+	span.End()
+
+	logs := core.GetReportedLogs()
+	if !assert.Len(t, logs, 1) {
+		return
+	}
+	assert.Equal(t, "captured", logs[0].GetEndpoint())
+	assert.Equal(t, span.TraceID(), logs[0].GetTraceContext().GetTraceId())
+	assert.Equal(t, span.TraceSegmentID(), logs[0].GetTraceContext().GetTraceSegmentId())
+	assert.Equal(t, span.SpanID(), logs[0].GetTraceContext().GetSpanId())
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	if assert.Len(t, spans, 1) {
+		assert.Empty(t, spans[0].Logs(), "the span of the snapshot should not be changed by another goroutine")
+	}
+}
+
+func TestLoggerLogWithSpanLog(t *testing.T) {
+	core.ResetTracingContext()
+	defer core.ResetTracingContext()
+
+	span, err := tracing.CreateLocalSpan("test")
+	assert.Nil(t, err)
+	logger := logging.WithFields(logging.String("user", "u1")).WithSpanLog()
+	invokeLoggerLog(t, logger, context.Background(), logging.InfoLevel, "span message", logging.Err(assert.AnError))
+	span.End()
+
+	time.Sleep(100 * time.Millisecond)
+	spans := core.GetReportedSpans()
+	if !assert.Len(t, spans, 1) || !assert.Len(t, spans[0].Logs(), 1) {
+		return
+	}
+	data := make(map[string]string)
+	for _, kv := range spans[0].Logs()[0].GetData() {
+		data[kv.Key] = kv.Value
+	}
+	assert.Equal(t, map[string]string{"event": "info", "message": "span message", "user": "u1",
+		"error": assert.AnError.Error()}, data)
+}
+
+func TestLevelEntryInterceptors(t *testing.T) {
+	tests := []struct {
+		interceptor operator.Interceptor
+		level       string
+	}{
+		{&DebugEntryInterceptor{}, debugLevel},
+		{&InfoEntryInterceptor{}, infoLevel},
+		{&WarnEntryInterceptor{}, warnLevel},
+		{&ErrorEntryInterceptor{}, errorLevel},
+	}
+	for _, test := range tests {
+		core.ResetTracingContext()
+		span, err := tracing.CreateLocalSpan("test")
+		assert.Nil(t, err)
+		invocation := operator.NewInvocation(nil, "entry message", []string{"foo", "bar"})
+		assert.Nil(t, test.interceptor.BeforeInvoke(invocation))
+		assert.Nil(t, test.interceptor.AfterInvoke(invocation))
+		span.End()
+
+		logs := core.GetReportedLogs()
+		if assert.Len(t, logs, 1, test.level) {
+			assert.Equal(t, "entry message", logs[0].GetBody().GetText().GetText())
+			assert.Equal(t, map[string]string{"LEVEL": test.level, "foo": "bar"}, logTags(logs[0]))
+		}
+	}
+	core.ResetTracingContext()
+}
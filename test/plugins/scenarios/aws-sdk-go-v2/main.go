@@ -0,0 +1,189 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	dynamodbtypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+const (
+	endpoint = "http://localstack:4566"
+	bucket   = "skywalking-bucket"
+	table    = "skywalking-table"
+	queue    = "skywalking-queue"
+	topic    = "skywalking-topic"
+)
+
+func main() {
+	cfg := aws.Config{
+		Region: "us-east-1",
+		Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "test", SecretAccessKey: "test"}, nil
+		}),
+		BaseEndpoint: aws.String(endpoint),
+	}
+	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.UsePathStyle = true
+	})
+	dynamodbClient := dynamodb.NewFromConfig(cfg)
+	sqsClient := sqs.NewFromConfig(cfg)
+	snsClient := sns.NewFromConfig(cfg)
+
+	created, err := sqsClient.CreateQueue(context.Background(), &sqs.CreateQueueInput{QueueName: aws.String(queue)})
+	if err != nil {
+		log.Fatalf("create queue error: %v", err)
+	}
+	queueURL := created.QueueUrl
+	go consume(sqsClient, queueURL)
+
+	route := http.NewServeMux()
+	route.HandleFunc("/execute", func(res http.ResponseWriter, req *http.Request) {
+		if err := testS3(req.Context(), s3Client); err != nil {
+			log.Printf("test s3 failed: %v", err)
+		}
+		if err := testDynamoDB(req.Context(), dynamodbClient); err != nil {
+			log.Printf("test dynamodb failed: %v", err)
+		}
+		if err := testSQS(req.Context(), sqsClient, queueURL); err != nil {
+			log.Printf("test sqs failed: %v", err)
+		}
+		if err := testSNS(req.Context(), snsClient); err != nil {
+			log.Printf("test sns failed: %v", err)
+		}
+		_, _ = res.Write([]byte("execute aws success"))
+	})
+
+	route.HandleFunc("/health", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("ok"))
+	})
+
+	log.Println("start client")
+	err = http.ListenAndServe(":8080", route)
+	if err != nil {
+		log.Fatalf("client start error: %v \n", err)
+	}
+}
+
+func testS3(ctx context.Context, client *s3.Client) error {
+	if _, err := client.CreateBucket(ctx, &s3.CreateBucketInput{Bucket: aws.String(bucket)}); err != nil {
+		return fmt.Errorf("create bucket error: %w", err)
+	}
+	if _, err := client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{Bucket: aws.String(bucket)}); err != nil {
+		return fmt.Errorf("list objects error: %w", err)
+	}
+	return nil
+}
+
+func testDynamoDB(ctx context.Context, client *dynamodb.Client) error {
+	_, err := client.CreateTable(ctx, &dynamodb.CreateTableInput{
+		TableName: aws.String(table),
+		AttributeDefinitions: []dynamodbtypes.AttributeDefinition{
+			{AttributeName: aws.String("id"), AttributeType: dynamodbtypes.ScalarAttributeTypeS},
+		},
+		KeySchema: []dynamodbtypes.KeySchemaElement{
+			{AttributeName: aws.String("id"), KeyType: dynamodbtypes.KeyTypeHash},
+		},
+		BillingMode: dynamodbtypes.BillingModePayPerRequest,
+	})
+	if err != nil {
+		return fmt.Errorf("create table error: %w", err)
+	}
+	_, err = client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(table),
+		Item: map[string]dynamodbtypes.AttributeValue{
+			"id":   &dynamodbtypes.AttributeValueMemberS{Value: "1"},
+			"name": &dynamodbtypes.AttributeValueMemberS{Value: "skywalking"},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("put item error: %w", err)
+	}
+	_, err = client.GetItem(ctx, &dynamodb.GetItemInput{
+		TableName: aws.String(table),
+		Key: map[string]dynamodbtypes.AttributeValue{
+			"id": &dynamodbtypes.AttributeValueMemberS{Value: "1"},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("get item error: %w", err)
+	}
+	return nil
+}
+
+func testSQS(ctx context.Context, client *sqs.Client, queueURL *string) error {
+	_, err := client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:    queueURL,
+		MessageBody: aws.String("hello skywalking"),
+	})
+	if err != nil {
+		return fmt.Errorf("send message error: %w", err)
+	}
+	return nil
+}
+
+func consume(client *sqs.Client, queueURL *string) {
+	for {
+		received, err := client.ReceiveMessage(context.Background(), &sqs.ReceiveMessageInput{
+			QueueUrl:            queueURL,
+			MaxNumberOfMessages: 1,
+			WaitTimeSeconds:     5,
+		})
+		if err != nil {
+			log.Printf("receive message error: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		for _, message := range received.Messages {
+			log.Printf("received message: %s", aws.ToString(message.Body))
+			if _, err := client.DeleteMessage(context.Background(), &sqs.DeleteMessageInput{
+				QueueUrl:      queueURL,
+				ReceiptHandle: message.ReceiptHandle,
+			}); err != nil {
+				log.Printf("delete message error: %v", err)
+			}
+		}
+	}
+}
+
+func testSNS(ctx context.Context, client *sns.Client) error {
+	created, err := client.CreateTopic(ctx, &sns.CreateTopicInput{Name: aws.String(topic)})
+	if err != nil {
+		return fmt.Errorf("create topic error: %w", err)
+	}
+	_, err = client.Publish(ctx, &sns.PublishInput{
+		TopicArn: created.TopicArn,
+		Message:  aws.String("hello skywalking"),
+	})
+	if err != nil {
+		return fmt.Errorf("publish error: %w", err)
+	}
+	return nil
+}
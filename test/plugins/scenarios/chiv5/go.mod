@@ -0,0 +1,5 @@
+module test/plugins/scenarios/chiv5
+
+go 1.24
+
+require github.com/go-chi/chi/v5 v5.0.0
@@ -0,0 +1,126 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+const watchPrefix = "/skywalking/watch/"
+
+func main() {
+	client, err := clientv3.New(clientv3.Config{
+		Endpoints:   []string{"etcd:2379"},
+		DialTimeout: 5 * time.Second,
+	})
+	if err != nil {
+		log.Fatalf("create client error: %v", err)
+	}
+	defer client.Close()
+
+	watchChan := client.Watch(context.Background(), watchPrefix, clientv3.WithPrefix())
+	go func() {
+		for resp := range watchChan {
+			for _, event := range resp.Events {
+				log.Printf("watched %s %s", event.Type, event.Kv.Key)
+			}
+		}
+	}()
+
+	route := http.NewServeMux()
+	route.HandleFunc("/execute", func(res http.ResponseWriter, req *http.Request) {
+		if err1 := testKV(req.Context(), client); err1 != nil {
+			log.Printf("test kv failed: %v", err1)
+		}
+		if err1 := testLock(req.Context(), client); err1 != nil {
+			log.Printf("test lock failed: %v", err1)
+		}
+		if err1 := testWatch(req.Context(), client); err1 != nil {
+			log.Printf("test watch failed: %v", err1)
+		}
+		_, _ = res.Write([]byte("execute etcd success"))
+	})
+
+	route.HandleFunc("/health", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("ok"))
+	})
+
+	log.Println("start client")
+	err = http.ListenAndServe(":8080", route)
+	if err != nil {
+		log.Fatalf("client start error: %v \n", err)
+	}
+}
+
+func testKV(ctx context.Context, client *clientv3.Client) error {
+	if _, err := client.Put(ctx, "/skywalking/foo", "bar"); err != nil {
+		return fmt.Errorf("put error: %w", err)
+	}
+	if _, err := client.Get(ctx, "/skywalking/foo"); err != nil {
+		return fmt.Errorf("get error: %w", err)
+	}
+	_, err := client.Txn(ctx).
+		If(clientv3.Compare(clientv3.Value("/skywalking/foo"), "=", "bar")).
+		Then(clientv3.OpPut("/skywalking/bar", "baz")).
+		Else(clientv3.OpGet("/skywalking/foo")).
+		Commit()
+	if err != nil {
+		return fmt.Errorf("txn error: %w", err)
+	}
+	if _, err := client.Delete(ctx, "/skywalking/bar"); err != nil {
+		return fmt.Errorf("delete error: %w", err)
+	}
+	// the lease is not granted, should be failed
+	if _, err := client.Put(ctx, "/skywalking/foo", "bar", clientv3.WithLease(0x1234)); err == nil {
+		return fmt.Errorf("put with unknown lease should fail")
+	}
+	return nil
+}
+
+func testLock(ctx context.Context, client *clientv3.Client) error {
+	session, err := concurrency.NewSession(client, concurrency.WithTTL(10), concurrency.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("create session error: %w", err)
+	}
+	defer session.Close()
+
+	mutex := concurrency.NewMutex(session, "/skywalking/lock")
+	if err := mutex.Lock(ctx); err != nil {
+		return fmt.Errorf("lock error: %w", err)
+	}
+	if err := mutex.Unlock(ctx); err != nil {
+		return fmt.Errorf("unlock error: %w", err)
+	}
+	return nil
+}
+
+func testWatch(ctx context.Context, client *clientv3.Client) error {
+	if _, err := client.Put(ctx, watchPrefix+"key", "value"); err != nil {
+		return fmt.Errorf("put watched key error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+var (
+	brokers  = []string{"kafka-server:9092"}
+	topic    = "sw-topic"
+	group    = "sw-group"
+	msg      = "I love skywalking 3 thousand"
+	producer *kgo.Client
+)
+
+func main() {
+	var err error
+	producer, err = kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.AllowAutoTopicCreation(),
+	)
+	if err != nil {
+		log.Fatal("create producer error: ", err)
+	}
+	defer producer.Close()
+	consumerHelper()
+
+	route := http.NewServeMux()
+	route.HandleFunc("/execute", func(res http.ResponseWriter, req *http.Request) {
+		if err := sendMessage(req.Context()); err != nil {
+			fmt.Printf("send message failed: %v\n", err)
+		}
+		_, _ = res.Write([]byte("execute success"))
+	})
+	route.HandleFunc("/health", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("ok"))
+	})
+	fmt.Println("start client")
+	err = http.ListenAndServe(":8080", route)
+	if err != nil {
+		fmt.Printf("client start error: %v \n", err)
+	}
+}
+
+func sendMessage(ctx context.Context) error {
+	return producer.ProduceSync(ctx, &kgo.Record{Topic: topic, Value: []byte(msg)}).FirstErr()
+}
+
+func consumerHelper() {
+	consumer, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.ConsumerGroup(group),
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.AllowAutoTopicCreation(),
+	)
+	if err != nil {
+		log.Fatal("create consumer error: ", err)
+	}
+	go func() {
+		for {
+			fetches := consumer.PollFetches(context.Background())
+			fetches.EachError(func(t string, p int32, err error) {
+				log.Printf("consume error, topic=%s, partition=%d: %v", t, p, err)
+			})
+			fetches.EachRecord(func(record *kgo.Record) {
+				fmt.Printf("consumer|topic=%s, partition=%d, offset=%d, value=%s\n",
+					record.Topic, record.Partition, record.Offset, string(record.Value))
+			})
+		}
+	}()
+}
@@ -0,0 +1,118 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+const restConfig = `
+Name: go-zero
+Host: 0.0.0.0
+Port: 8080
+Log:
+  Mode: console
+  Level: error
+`
+
+// the health service is registered by the zRPC server
+const rpcServerConfig = `
+Name: go-zero.rpc
+ListenOn: 127.0.0.1:9999
+Log:
+  Mode: console
+  Level: error
+`
+
+const rpcClientConfig = `
+Endpoints:
+  - 127.0.0.1:9999
+`
+
+func main() {
+	var serverConf zrpc.RpcServerConf
+	mustLoad(rpcServerConfig, &serverConf)
+	rpcServer := zrpc.MustNewServer(serverConf, func(s *grpc.Server) {})
+	go rpcServer.Start()
+
+	var clientConf zrpc.RpcClientConf
+	mustLoad(rpcClientConfig, &clientConf)
+	client := grpc_health_v1.NewHealthClient(zrpc.MustNewClient(clientConf).Conn())
+
+	var restConf rest.RestConf
+	mustLoad(restConfig, &restConf)
+	server := rest.MustNewServer(restConf)
+	server.AddRoutes([]rest.Route{
+		{Method: http.MethodGet, Path: "/consumer", Handler: consumerHandler(client)},
+		{Method: http.MethodGet, Path: "/provider/:id", Handler: providerHandler},
+		{Method: http.MethodGet, Path: "/health", Handler: func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}},
+	})
+	server.Start()
+}
+
+func providerHandler(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte("success"))
+}
+
+func consumerHandler(client grpc_health_v1.HealthClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if _, err := client.Check(r.Context(), &grpc_health_v1.HealthCheckRequest{}); err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		// the unknown service returns the NotFound error
+		if _, err := client.Check(r.Context(), &grpc_health_v1.HealthCheckRequest{Service: "fail"}); err == nil {
+			log.Print("the check of unknown service should return error")
+		}
+
+		req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, "http://localhost:8080/provider/1", http.NoBody)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			log.Print(err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		_, _ = w.Write(body)
+	}
+}
+
+func mustLoad(content string, v interface{}) {
+	if err := conf.LoadFromYamlBytes([]byte(content), v); err != nil {
+		log.Fatal(fmt.Errorf("load config failure: %v", err))
+	}
+}
@@ -0,0 +1,111 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied. See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gocql/gocql"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+const (
+	host     = "cassandra"
+	keyspace = "demo"
+)
+
+func main() {
+	session, err := createSession()
+	if err != nil {
+		log.Fatalf("create session error: %v", err)
+	}
+
+	route := http.NewServeMux()
+	route.HandleFunc("/execute", func(res http.ResponseWriter, req *http.Request) {
+		if err1 := testQuery(req.Context(), session); err1 != nil {
+			log.Printf("test query failed: %v", err1)
+		}
+		if err1 := testBatch(req.Context(), session); err1 != nil {
+			log.Printf("test batch failed: %v", err1)
+		}
+		_, _ = res.Write([]byte("execute cql success"))
+	})
+
+	route.HandleFunc("/health", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("ok"))
+	})
+
+	log.Println("start client")
+	err = http.ListenAndServe(":8080", route)
+	if err != nil {
+		log.Fatalf("client start error: %v \n", err)
+	}
+}
+
+func createSession() (*gocql.Session, error) {
+	cluster := gocql.NewCluster(host)
+	cluster.Consistency = gocql.One
+	cluster.Timeout = 10 * time.Second
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return nil, err
+	}
+	defer session.Close()
+	if err = session.Query(`CREATE KEYSPACE IF NOT EXISTS demo
+		WITH replication = {'class': 'SimpleStrategy', 'replication_factor': 1}`).Exec(); err != nil {
+		return nil, fmt.Errorf("create keyspace error: %w", err)
+	}
+	if err = session.Query(`CREATE TABLE IF NOT EXISTS demo.users (id int PRIMARY KEY, name text)`).Exec(); err != nil {
+		return nil, fmt.Errorf("create table error: %w", err)
+	}
+
+	cluster.Keyspace = keyspace
+	return cluster.CreateSession()
+}
+
+func testQuery(ctx context.Context, session *gocql.Session) error {
+	if err := session.Query(`INSERT INTO users (id, name) VALUES (?, ?)`, 1, "foo").
+		WithContext(ctx).Consistency(gocql.LocalOne).Exec(); err != nil {
+		return fmt.Errorf("insert error: %w", err)
+	}
+
+	var name string
+	if err := session.Query(`SELECT name FROM users WHERE id = ?`, 1).WithContext(ctx).Scan(&name); err != nil {
+		return fmt.Errorf("select error: %w", err)
+	}
+
+	if err := session.Query(`SELECT name FROM unknown_table`).WithContext(ctx).Exec(); err == nil {
+		return fmt.Errorf("select from unknown table should fail")
+	}
+	return nil
+}
+
+func testBatch(ctx context.Context, session *gocql.Session) error {
+	batch := session.NewBatch(gocql.LoggedBatch).WithContext(ctx)
+	batch.Query(`INSERT INTO users (id, name) VALUES (?, ?)`, 2, "bar")
+	batch.Query(`INSERT INTO users (id, name) VALUES (?, ?)`, 3, "foobar")
+	if err := session.ExecuteBatch(batch); err != nil {
+		return fmt.Errorf("execute batch error: %w", err)
+	}
+	return nil
+}
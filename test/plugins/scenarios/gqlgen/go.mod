@@ -0,0 +1,15 @@
+module test/plugins/scenarios/gqlgen
+
+go 1.24
+
+require (
+	github.com/99designs/gqlgen v0.17.18
+	github.com/vektah/gqlparser/v2 v2.5.1
+)
+
+require (
+	github.com/agnivade/levenshtein v1.1.1 // indirect
+	github.com/gorilla/websocket v1.5.0 // indirect
+	github.com/hashicorp/golang-lru v0.5.4 // indirect
+	github.com/mitchellh/mapstructure v1.3.1 // indirect
+)
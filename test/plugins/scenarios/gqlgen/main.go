@@ -0,0 +1,156 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+const schemaDefinition = `
+type Query {
+	user(id: ID!): User
+}
+
+type Mutation {
+	createUser(name: String!): User
+}
+
+type User {
+	id: ID!
+	name: String!
+}
+`
+
+var operations = []string{
+	`{"operationName":"GetUser","query":"query GetUser { user(id: \"1\") { id name } }"}`,
+	`{"query":"mutation CreateUser { createUser(name: \"skywalking\") { id } }"}`,
+	`{"query":"query GetMissingUser { user(id: \"0\") { name } }"}`,
+}
+
+type user struct {
+	ID   string
+	Name string
+}
+
+func resolve(ctx context.Context) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	switch fc.Field.Name {
+	case "user":
+		if fc.Args["id"] == "0" {
+			return nil, errors.New("user not found")
+		}
+		return &user{ID: fc.Args["id"].(string), Name: "skywalking"}, nil
+	case "createUser":
+		return &user{ID: "2", Name: fc.Args["name"].(string)}, nil
+	}
+	return nil, errors.New("unknown field " + fc.Field.Name)
+}
+
+// execute resolves the root fields through the resolver middleware as the generated code does,
+// so the scenario is not bound to the code generated by a specific gqlgen version
+func execute(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	rootType := "Query"
+	if opCtx.Operation.Operation == ast.Mutation {
+		rootType = "Mutation"
+	}
+	executed := false
+	return func(ctx context.Context) *graphql.Response {
+		if executed {
+			return nil
+		}
+		executed = true
+
+		data := make(map[string]interface{})
+		for _, field := range graphql.CollectFields(opCtx, opCtx.Operation.SelectionSet, []string{rootType}) {
+			fc := &graphql.FieldContext{Object: rootType, Field: field, Args: field.ArgumentMap(opCtx.Variables),
+				IsMethod: true, IsResolver: true}
+			fieldCtx := graphql.WithFieldContext(ctx, fc)
+			result, err := opCtx.ResolverMiddleware(fieldCtx, resolve)
+			if err != nil {
+				graphql.AddError(fieldCtx, err)
+				data[field.Alias] = nil
+				continue
+			}
+			value := make(map[string]interface{})
+			for _, child := range graphql.CollectFields(opCtx, field.Selections, []string{"User"}) {
+				switch child.Name {
+				case "id":
+					value[child.Alias] = result.(*user).ID
+				case "name":
+					value[child.Alias] = result.(*user).Name
+				}
+			}
+			data[field.Alias] = value
+		}
+		marshaled, err := json.Marshal(data)
+		if err != nil {
+			graphql.AddError(ctx, err)
+		}
+		return &graphql.Response{Data: marshaled}
+	}
+}
+
+func consumer(w http.ResponseWriter, r *http.Request) {
+	for _, operation := range operations {
+		resp, err := http.Post("http://localhost:8080/graphql", "application/json", bytes.NewBufferString(operation))
+		if err != nil {
+			log.Printf("request graphql error: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+	_, _ = w.Write([]byte("success"))
+}
+
+func health(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte("success"))
+}
+
+func main() {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaDefinition})
+	server := handler.New(&graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema {
+			return schema
+		},
+		ExecFunc: execute,
+	})
+	server.AddTransport(transport.POST{})
+
+	http.Handle("/graphql", server)
+	http.HandleFunc("/consumer", consumer)
+	http.HandleFunc("/health", health)
+
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
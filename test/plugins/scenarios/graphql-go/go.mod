@@ -0,0 +1,5 @@
+module test/plugins/scenarios/graphql-go
+
+go 1.24
+
+require github.com/graph-gophers/graphql-go v1.6.0
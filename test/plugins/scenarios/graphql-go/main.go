@@ -0,0 +1,111 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+const schemaDefinition = `
+schema {
+	query: Query
+	mutation: Mutation
+}
+
+type Query {
+	user(id: ID!): User
+}
+
+type Mutation {
+	createUser(name: String!): User
+}
+
+type User {
+	id: ID!
+	name: String!
+}
+`
+
+var operations = []string{
+	`{"operationName":"GetUser","query":"query GetUser { user(id: \"1\") { id name } }"}`,
+	`{"query":"mutation CreateUser { createUser(name: \"skywalking\") { id } }"}`,
+	`{"query":"query GetMissingUser { user(id: \"0\") { name } }"}`,
+}
+
+type resolver struct{}
+
+func (r *resolver) User(args struct{ ID graphql.ID }) (*userResolver, error) {
+	if args.ID == "0" {
+		return nil, errors.New("user not found")
+	}
+	return &userResolver{id: args.ID, name: "skywalking"}, nil
+}
+
+func (r *resolver) CreateUser(args struct{ Name string }) *userResolver {
+	return &userResolver{id: "2", name: args.Name}
+}
+
+type userResolver struct {
+	id   graphql.ID
+	name string
+}
+
+func (u *userResolver) ID() graphql.ID {
+	return u.id
+}
+
+func (u *userResolver) Name() string {
+	return u.name
+}
+
+func consumer(w http.ResponseWriter, r *http.Request) {
+	for _, operation := range operations {
+		resp, err := http.Post("http://localhost:8080/graphql", "application/json", bytes.NewBufferString(operation))
+		if err != nil {
+			log.Printf("request graphql error: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+	_, _ = w.Write([]byte("success"))
+}
+
+func health(w http.ResponseWriter, r *http.Request) {
+	_, _ = w.Write([]byte("success"))
+}
+
+func main() {
+	schema := graphql.MustParseSchema(schemaDefinition, &resolver{})
+
+	http.Handle("/graphql", &relay.Handler{Schema: schema})
+	http.HandleFunc("/consumer", consumer)
+	http.HandleFunc("/health", health)
+
+	log.Fatal(http.ListenAndServe(":8080", nil))
+}
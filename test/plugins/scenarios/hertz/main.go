@@ -0,0 +1,65 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/app/client"
+	"github.com/cloudwego/hertz/pkg/app/server"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+func main() {
+	hertzClient, err := client.NewClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	h := server.New(server.WithHostPorts(":8080"))
+	h.GET("/consumer", func(c context.Context, ctx *app.RequestContext) {
+		_, body, err := hertzClient.Get(c, nil, "http://localhost:8080/provider/1")
+		if err != nil {
+			log.Print(err)
+			ctx.Status(http.StatusInternalServerError)
+			return
+		}
+
+		if _, _, err = hertzClient.Get(c, nil, "http://localhost:8080/notFound"); err != nil {
+			log.Print(err)
+			ctx.Status(http.StatusInternalServerError)
+			return
+		}
+
+		ctx.String(http.StatusOK, string(body))
+	})
+
+	h.GET("/provider/:id", func(c context.Context, ctx *app.RequestContext) {
+		ctx.String(http.StatusOK, "success")
+	})
+
+	h.GET("/health", func(c context.Context, ctx *app.RequestContext) {
+		ctx.Status(http.StatusOK)
+	})
+
+	h.Spin()
+}
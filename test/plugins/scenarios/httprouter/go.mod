@@ -0,0 +1,5 @@
+module test/plugins/scenarios/httprouter
+
+go 1.24
+
+require github.com/julienschmidt/httprouter v1.1.0
@@ -0,0 +1,114 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/client/genericclient"
+	"github.com/cloudwego/kitex/pkg/generic"
+	"github.com/cloudwego/kitex/server"
+	"github.com/cloudwego/kitex/server/genericserver"
+	"github.com/cloudwego/kitex/transport"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+const echoIDL = `
+namespace go api
+
+struct Request {
+    1: string message
+}
+
+struct Response {
+    1: string message
+}
+
+service Echo {
+    Response echo(1: Request req)
+    Response fail(1: Request req)
+}
+`
+
+type echoService struct {
+}
+
+func (e *echoService) GenericCall(ctx context.Context, method string, request interface{}) (interface{}, error) {
+	if method == "fail" {
+		return nil, errors.New("fail")
+	}
+	return `{"message":"success"}`, nil
+}
+
+func newGeneric() generic.Generic {
+	provider, err := generic.NewThriftContentProvider(echoIDL, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	g, err := generic.JSONThriftGeneric(provider)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return g
+}
+
+func main() {
+	addr, err := net.ResolveTCPAddr("tcp", "127.0.0.1:9999")
+	if err != nil {
+		log.Fatal(err)
+	}
+	svr := genericserver.NewServer(&echoService{}, newGeneric(), server.WithServiceAddr(addr))
+	go func() {
+		if err := svr.Run(); err != nil {
+			log.Fatal(err)
+		}
+	}()
+
+	// the tracing context is propagated by the TTHeader
+	cli, err := genericclient.NewClient("echo", newGeneric(), client.WithHostPorts("127.0.0.1:9999"),
+		client.WithTransportProtocol(transport.TTHeader), client.WithRPCTimeout(3*time.Second))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	http.HandleFunc("/consumer", func(writer http.ResponseWriter, request *http.Request) {
+		resp, err := cli.GenericCall(request.Context(), "echo", `{"message":"hello"}`)
+		if err != nil {
+			log.Print(err)
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if _, err = cli.GenericCall(request.Context(), "fail", `{"message":"hello"}`); err == nil {
+			log.Print("the fail method should return error")
+		}
+		_, _ = writer.Write([]byte(resp.(string)))
+	})
+
+	http.HandleFunc("/health", func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusOK)
+	})
+
+	_ = http.ListenAndServe(":8080", nil)
+}
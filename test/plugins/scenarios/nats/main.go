@@ -0,0 +1,118 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+var (
+	url            = "nats://nats-server:4222"
+	subject        = "sw-subject"
+	requestSubject = "sw-request"
+	queue          = "sw-queue"
+	stream         = "sw-stream"
+	streamSubject  = "sw-stream.orders"
+	msg            = "I love skywalking 3 thousand"
+	conn           *nats.Conn
+	js             jetstream.JetStream
+)
+
+func main() {
+	var err error
+	conn, err = nats.Connect(url)
+	if err != nil {
+		log.Fatal("connect nats error: ", err)
+	}
+	defer conn.Close()
+	js, err = jetstream.New(conn)
+	if err != nil {
+		log.Fatal("create jetstream error: ", err)
+	}
+	consumerHelper()
+
+	route := http.NewServeMux()
+	route.HandleFunc("/execute", func(res http.ResponseWriter, req *http.Request) {
+		if err := sendMessage(req.Context()); err != nil {
+			fmt.Printf("send message failed: %v\n", err)
+		}
+		_, _ = res.Write([]byte("execute success"))
+	})
+	route.HandleFunc("/health", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("ok"))
+	})
+	fmt.Println("start client")
+	err = http.ListenAndServe(":8080", route)
+	if err != nil {
+		fmt.Printf("client start error: %v \n", err)
+	}
+}
+
+func sendMessage(ctx context.Context) error {
+	if err := conn.Publish(subject, []byte(msg)); err != nil {
+		return err
+	}
+	reply, err := conn.Request(requestSubject, []byte(msg), 3*time.Second)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("reply|data=%s\n", string(reply.Data))
+	_, err = js.Publish(ctx, streamSubject, []byte(msg))
+	return err
+}
+
+func consumerHelper() {
+	if _, err := conn.Subscribe(subject, func(m *nats.Msg) {
+		fmt.Printf("subscriber|subject=%s, data=%s\n", m.Subject, string(m.Data))
+	}); err != nil {
+		log.Fatal("subscribe error: ", err)
+	}
+	if _, err := conn.QueueSubscribe(requestSubject, queue, func(m *nats.Msg) {
+		if err := m.Respond([]byte("pong")); err != nil {
+			fmt.Printf("respond error: %v\n", err)
+		}
+	}); err != nil {
+		log.Fatal("queue subscribe error: ", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	s, err := js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{Name: stream, Subjects: []string{stream + ".>"}})
+	if err != nil {
+		log.Fatal("create stream error: ", err)
+	}
+	consumer, err := s.CreateOrUpdateConsumer(ctx, jetstream.ConsumerConfig{Durable: "sw-consumer", AckPolicy: jetstream.AckExplicitPolicy})
+	if err != nil {
+		log.Fatal("create consumer error: ", err)
+	}
+	if _, err := consumer.Consume(func(m jetstream.Msg) {
+		fmt.Printf("jetstream consumer|subject=%s, data=%s\n", m.Subject(), string(m.Data()))
+		_ = m.Ack()
+	}); err != nil {
+		log.Fatal("consume error: ", err)
+	}
+}
@@ -0,0 +1,5 @@
+module test/plugins/scenarios/pq
+
+go 1.24
+
+require github.com/lib/pq v1.9.0
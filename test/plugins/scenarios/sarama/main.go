@@ -0,0 +1,124 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	_ "github.com/apache/skywalking-go"
+)
+
+var (
+	brokers  = []string{"kafka-server:9092"}
+	topic    = "sw-topic"
+	group    = "sw-group"
+	msg      = "I love skywalking 3 thousand"
+	producer sarama.SyncProducer
+)
+
+type consumerHandler struct {
+}
+
+func (h *consumerHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *consumerHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *consumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for message := range claim.Messages() {
+		fmt.Printf("consumer|topic=%s, partition=%d, offset=%d, value=%s\n",
+			message.Topic, message.Partition, message.Offset, string(message.Value))
+		session.MarkMessage(message, "")
+	}
+	return nil
+}
+
+func main() {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	createTopic(config)
+	var err error
+	producer, err = sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		log.Fatal("create producer error: ", err)
+	}
+	defer producer.Close()
+	consumerHelper(config)
+
+	route := http.NewServeMux()
+	route.HandleFunc("/execute", func(res http.ResponseWriter, req *http.Request) {
+		if err := sendMessage(); err != nil {
+			fmt.Printf("send message failed: %v\n", err)
+		}
+		_, _ = res.Write([]byte("execute success"))
+	})
+	route.HandleFunc("/health", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte("ok"))
+	})
+	fmt.Println("start client")
+	err = http.ListenAndServe(":8080", route)
+	if err != nil {
+		fmt.Printf("client start error: %v \n", err)
+	}
+}
+
+func sendMessage() error {
+	_, _, err := producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.StringEncoder(msg),
+	})
+	return err
+}
+
+func consumerHelper(config *sarama.Config) {
+	consumerGroup, err := sarama.NewConsumerGroup(brokers, group, config)
+	if err != nil {
+		log.Fatal("create consumer group error: ", err)
+	}
+	go func() {
+		for {
+			if err := consumerGroup.Consume(context.Background(), []string{topic}, &consumerHandler{}); err != nil {
+				log.Printf("consume error: %v", err)
+				time.Sleep(time.Second)
+			}
+		}
+	}()
+}
+
+func createTopic(config *sarama.Config) {
+	admin, err := sarama.NewClusterAdmin(brokers, config)
+	if err != nil {
+		log.Fatal("create cluster admin error: ", err)
+	}
+	defer admin.Close()
+	err = admin.CreateTopic(topic, &sarama.TopicDetail{NumPartitions: 1, ReplicationFactor: 1}, false)
+	if err != nil {
+		log.Fatal("create topic error: ", err)
+	}
+}
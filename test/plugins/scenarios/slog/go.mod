@@ -0,0 +1,3 @@
+module test/plugins/scenarios/slog
+
+go 1.24
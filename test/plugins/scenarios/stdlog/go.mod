@@ -0,0 +1,3 @@
+module test/plugins/scenarios/stdlog
+
+go 1.24
@@ -0,0 +1,11 @@
+module test/plugins/scenarios/zerolog
+
+go 1.24
+
+require github.com/rs/zerolog v1.30.0
+
+require (
+	github.com/mattn/go-colorable v0.1.12 // indirect
+	github.com/mattn/go-isatty v0.0.14 // indirect
+	golang.org/x/sys v0.13.0 // indirect
+)
@@ -17,6 +17,8 @@
 
 package logging
 
+import "context"
+
 // Debug logs a message at DebugLevel
 func Debug(msg string, keyValues ...string) {
 
@@ -35,3 +37,25 @@ func Warn(msg string, keyValues ...string) {
 // Error logs a message at ErrorLevel
 func Error(msg string, keyValues ...string) {
 }
+
+var defaultLogger = &Logger{}
+
+// DebugContext logs a message at DebugLevel with the context and typed attributes
+func DebugContext(ctx context.Context, msg string, attrs ...Attr) {
+	defaultLogger.Log(ctx, DebugLevel, msg, attrs...)
+}
+
+// InfoContext logs a message at InfoLevel with the context and typed attributes
+func InfoContext(ctx context.Context, msg string, attrs ...Attr) {
+	defaultLogger.Log(ctx, InfoLevel, msg, attrs...)
+}
+
+// WarnContext logs a message at WarnLevel with the context and typed attributes
+func WarnContext(ctx context.Context, msg string, attrs ...Attr) {
+	defaultLogger.Log(ctx, WarnLevel, msg, attrs...)
+}
+
+// ErrorContext logs a message at ErrorLevel with the context and typed attributes
+func ErrorContext(ctx context.Context, msg string, attrs ...Attr) {
+	defaultLogger.Log(ctx, ErrorLevel, msg, attrs...)
+}
@@ -0,0 +1,77 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logging
+
+import (
+	"fmt"
+	"time"
+)
+
+// Attr is a key-value pair of the log, the type of the value is kept when reporting
+type Attr struct {
+	Key   string
+	Value interface{}
+}
+
+// ValueString returns the value in the string format
+func (a Attr) ValueString() string {
+	if s, ok := a.Value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(a.Value)
+}
+
+// String returns an Attr for a string value
+func String(key, value string) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Int returns an Attr for an int value
+func Int(key string, value int) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Int64 returns an Attr for an int64 value
+func Int64(key string, value int64) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Float64 returns an Attr for a float64 value
+func Float64(key string, value float64) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Bool returns an Attr for a bool value
+func Bool(key string, value bool) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Duration returns an Attr for a time.Duration value
+func Duration(key string, value time.Duration) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Err returns an Attr for an error value with the "error" key
+func Err(err error) Attr {
+	return Attr{Key: "error", Value: err}
+}
+
+// Any returns an Attr for any value
+func Any(key string, value interface{}) Attr {
+	return Attr{Key: key, Value: value}
+}
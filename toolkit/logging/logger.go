@@ -0,0 +1,132 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logging
+
+import (
+	"context"
+
+	"github.com/apache/skywalking-go/toolkit/trace"
+)
+
+// Level of the log
+type Level string
+
+const (
+	DebugLevel Level = "debug"
+	InfoLevel  Level = "info"
+	WarnLevel  Level = "warn"
+	ErrorLevel Level = "error"
+)
+
+type snapshotContextKey struct{}
+
+// ContextWithSnapshot binds the captured tracing context to the context.Context,
+// the logs with the returned context would be bound to the span of the snapshot
+func ContextWithSnapshot(ctx context.Context, snapshot trace.ContextSnapshotRef) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, snapshotContextKey{}, snapshot)
+}
+
+// SnapshotFromContext get the captured tracing context from the context.Context
+func SnapshotFromContext(ctx context.Context) trace.ContextSnapshotRef {
+	if ctx == nil {
+		return nil
+	}
+	return ctx.Value(snapshotContextKey{})
+}
+
+// Logger logs with the fields, it could be reused and shared between goroutines
+type Logger struct {
+	fields  []Attr
+	spanLog bool
+}
+
+// WithFields returns a Logger with the fields
+func WithFields(attrs ...Attr) *Logger {
+	return (&Logger{}).WithFields(attrs...)
+}
+
+// WithFields returns a new Logger with the fields appended
+func (l *Logger) WithFields(attrs ...Attr) *Logger {
+	fields := make([]Attr, 0, len(l.fields)+len(attrs))
+	fields = append(fields, l.fields...)
+	return &Logger{fields: append(fields, attrs...), spanLog: l.spanLog}
+}
+
+// WithSpanLog returns a new Logger which also records the logs as the logs of the active span
+func (l *Logger) WithSpanLog() *Logger {
+	return &Logger{fields: l.fields, spanLog: true}
+}
+
+// Fields of the Logger
+func (l *Logger) Fields() []Attr {
+	return l.fields
+}
+
+// SpanLogEnabled check the logs should be recorded as the logs of the active span
+func (l *Logger) SpanLogEnabled() bool {
+	return l.spanLog
+}
+
+// Debug logs a message at DebugLevel
+func (l *Logger) Debug(msg string, attrs ...Attr) {
+	l.Log(context.Background(), DebugLevel, msg, attrs...)
+}
+
+// Info logs a message at InfoLevel
+func (l *Logger) Info(msg string, attrs ...Attr) {
+	l.Log(context.Background(), InfoLevel, msg, attrs...)
+}
+
+// Warn logs a message at WarnLevel
+func (l *Logger) Warn(msg string, attrs ...Attr) {
+	l.Log(context.Background(), WarnLevel, msg, attrs...)
+}
+
+// Error logs a message at ErrorLevel
+func (l *Logger) Error(msg string, attrs ...Attr) {
+	l.Log(context.Background(), ErrorLevel, msg, attrs...)
+}
+
+// DebugContext logs a message at DebugLevel with the context
+func (l *Logger) DebugContext(ctx context.Context, msg string, attrs ...Attr) {
+	l.Log(ctx, DebugLevel, msg, attrs...)
+}
+
+// InfoContext logs a message at InfoLevel with the context
+func (l *Logger) InfoContext(ctx context.Context, msg string, attrs ...Attr) {
+	l.Log(ctx, InfoLevel, msg, attrs...)
+}
+
+// WarnContext logs a message at WarnLevel with the context
+func (l *Logger) WarnContext(ctx context.Context, msg string, attrs ...Attr) {
+	l.Log(ctx, WarnLevel, msg, attrs...)
+}
+
+// ErrorContext logs a message at ErrorLevel with the context
+func (l *Logger) ErrorContext(ctx context.Context, msg string, attrs ...Attr) {
+	l.Log(ctx, ErrorLevel, msg, attrs...)
+}
+
+// Log logs a message at the level, the log is bound to the span of the snapshot in the context,
+// or the active span in the current goroutine when there is no snapshot in the context
+// nolint
+func (l *Logger) Log(ctx context.Context, level Level, msg string, attrs ...Attr) {
+}
@@ -66,20 +66,55 @@ type Log struct {
 	Type     StringValue `yaml:"type"`
 	Tracing  LogTracing  `yaml:"tracing"`
 	Reporter LogReporter `yaml:"reporter"`
+	Std      LogStd      `yaml:"std"`
 }
 
 type LogTracing struct {
-	Enabled StringValue `yaml:"enable"`
-	Key     StringValue `yaml:"key"`
+	Enabled  StringValue      `yaml:"enable"`
+	Key      StringValue      `yaml:"key"`
+	Layout   StringValue      `yaml:"layout"`
+	Fields   LogTracingFields `yaml:"fields"`
+	Template StringValue      `yaml:"template"`
+}
+
+type LogTracingFields struct {
+	Service   StringValue `yaml:"service"`
+	Instance  StringValue `yaml:"instance"`
+	TraceID   StringValue `yaml:"trace_id"`
+	SegmentID StringValue `yaml:"segment_id"`
+	SpanID    StringValue `yaml:"span_id"`
 }
 
 type LogReporter struct {
-	Enabled   StringValue `yaml:"enable"`
-	LabelKeys StringValue `yaml:"label_keys"`
+	Enabled            StringValue `yaml:"enable"`
+	LabelKeys          StringValue `yaml:"label_keys"`
+	JSONBodyFrameworks StringValue `yaml:"json_body_frameworks"`
+	ErrorStack         StringValue `yaml:"error_stack"`
+	MinLevel           StringValue `yaml:"min_level"`
+	RateLimit          StringValue `yaml:"rate_limit"`
+	SampledOnly        StringValue `yaml:"sampled_only"`
+	AlwaysWarn         StringValue `yaml:"always_warn"`
+}
+
+type LogStd struct {
+	Enabled       StringValue `yaml:"enable"`
+	ContextPrefix StringValue `yaml:"context_prefix"`
+	Level         StringValue `yaml:"level"`
 }
 
 type Meter struct {
 	CollectInterval StringValue `yaml:"collect_interval"`
+	Views           []MeterView `yaml:"views"`
+}
+
+type MeterView struct {
+	Match            StringValue `yaml:"match"`
+	Drop             StringValue `yaml:"drop"`
+	Rename           StringValue `yaml:"rename"`
+	ExcludeLabels    StringValue `yaml:"exclude_labels"`
+	IncludeLabels    StringValue `yaml:"include_labels"`
+	Buckets          StringValue `yaml:"buckets"`
+	GaugeAggregation StringValue `yaml:"gauge_aggregation"`
 }
 
 type GRPCReporter struct {
@@ -221,19 +256,44 @@ func (s *StringValue) UnmarshalString(val string) {
 	s.Default = groups[2]
 }
 
+// GoValueFunctions defines the functions used by the generated Go code to read the value,
+// the package which cannot import the default packages could provide its own implementation
+type GoValueFunctions struct {
+	// GetEnv reads the environment value, the default is "os.Getenv"
+	GetEnv string
+	// EqualFold compares the bool value, the default is "strings.EqualFold"
+	EqualFold string
+	// Split splits the list value, the default is "strings.Split"
+	Split string
+}
+
+var DefaultGoValueFunctions = &GoValueFunctions{
+	GetEnv:    "os.Getenv",
+	EqualFold: "strings.EqualFold",
+	Split:     "strings.Split",
+}
+
 func (s *StringValue) ToGoStringValue() string {
+	return s.ToGoStringValueBy(DefaultGoValueFunctions)
+}
+
+func (s *StringValue) ToGoStringValueBy(funcs *GoValueFunctions) string {
 	return strings.ReplaceAll(fmt.Sprintf(`func() string {
 	if "%s" == "" { return "%s"}
-	tmpValue := os.Getenv("%s")
+	tmpValue := %s("%s")
 	if tmpValue == "" { return "%s"}
 	return tmpValue
-}()`, s.EnvKey, s.Default, s.EnvKey, s.Default), "\n", ";")
+}()`, s.EnvKey, s.Default, funcs.GetEnv, s.EnvKey, s.Default), "\n", ";")
 }
 
 func (s *StringValue) ToGoStringListValue() string {
+	return s.ToGoStringListValueBy(DefaultGoValueFunctions)
+}
+
+func (s *StringValue) ToGoStringListValueBy(funcs *GoValueFunctions) string {
 	return strings.ReplaceAll(fmt.Sprintf(`func() []string {
 	splitResult := func(s string) []string {
-		t := strings.Split(s, ",")
+		t := %s(s, ",")
 		if len(t) == 1 && t[0] == "" { return nil }
 		res := make([]string, 0, 0)
 		for _, v := range t {
@@ -244,10 +304,10 @@ func (s *StringValue) ToGoStringListValue() string {
 		return res
 	}
 	if "%s" == "" { return splitResult("%s") }
-	tmpValue := os.Getenv("%s")
+	tmpValue := %s("%s")
 	if tmpValue == "" { return splitResult("%s") }
 	return splitResult(tmpValue)
-}()`, s.EnvKey, s.Default, s.EnvKey, s.Default), "\n", ";")
+}()`, funcs.Split, s.EnvKey, s.Default, funcs.GetEnv, s.EnvKey, s.Default), "\n", ";")
 }
 
 func (s *StringValue) ToGoStringFunction() string {
@@ -287,13 +347,17 @@ func (s *StringValue) ToGoFloatFunction(errorMessage string) string {
 }
 
 func (s *StringValue) ToGoBoolValue() string {
+	return s.ToGoBoolValueBy(DefaultGoValueFunctions)
+}
+
+func (s *StringValue) ToGoBoolValueBy(funcs *GoValueFunctions) string {
 	return strings.ReplaceAll(fmt.Sprintf(`func() bool {
 	if "%s" == "" {return %s}
-	tmpValue := os.Getenv("%s")
+	tmpValue := %s("%s")
 	if tmpValue == "" {return %s}
-	return strings.EqualFold(tmpValue, "true")
+	return %s(tmpValue, "true")
 }()`,
-		s.EnvKey, s.Default, s.EnvKey, s.Default), "\n", ";")
+		s.EnvKey, s.Default, funcs.GetEnv, s.EnvKey, s.Default, funcs.EqualFold), "\n", ";")
 }
 
 func (s *StringValue) ToGoBoolFunction() string {
@@ -338,6 +402,13 @@ func combineConfigFields(field1, field2 reflect.Value) {
 		panic("config are not the same")
 	}
 
+	// the list is replaced when it has been defined
+	if field1.Kind() == reflect.Slice {
+		if field2.Len() > 0 {
+			field1.Set(field2)
+		}
+		return
+	}
 	if field1.Kind() == reflect.Struct {
 		if s, ok := field1.Addr().Interface().(*StringValue); ok {
 			s2 := field2.Addr().Interface().(*StringValue)
@@ -33,3 +33,17 @@ func TestLoadConfig(t *testing.T) {
 	expected = StringValue{EnvKey: "SW_AGENT_SAMPLE", Default: "0.1"}
 	assert.Equal(t, expected, conf.Agent.Sampler)
 }
+
+func TestToGoValueByFunctions(t *testing.T) {
+	value := StringValue{EnvKey: "SW_TEST_KEY", Default: "true"}
+	assert.Contains(t, value.ToGoBoolValue(), `os.Getenv("SW_TEST_KEY")`)
+
+	funcs := &GoValueFunctions{GetEnv: "getEnvValue", EqualFold: "equalFoldValue", Split: "splitValue"}
+	for _, code := range []string{value.ToGoBoolValueBy(funcs), value.ToGoStringValueBy(funcs), value.ToGoStringListValueBy(funcs)} {
+		assert.Contains(t, code, `getEnvValue("SW_TEST_KEY")`)
+		assert.NotContains(t, code, "os.Getenv")
+		assert.NotContains(t, code, "strings.")
+	}
+	assert.Contains(t, value.ToGoBoolValueBy(funcs), `equalFoldValue(tmpValue, "true")`)
+	assert.Contains(t, value.ToGoStringListValueBy(funcs), `splitValue(s, ",")`)
+}
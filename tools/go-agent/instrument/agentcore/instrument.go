@@ -172,6 +172,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	_ "unsafe"
 )
 
@@ -199,6 +200,22 @@ func (t *Tracer) InitTracer(extend map[string]interface{}) {
 	}
 	ignoreSuffixStr := {{.Config.Agent.IgnoreSuffix.ToGoStringValue}}
 	ignorePath := {{.Config.Agent.TraceIgnorePath.ToGoStringValue}}
+	t.meterViews = []*MeterView{
+{{- range .Config.Agent.Meter.Views }}
+		NewMeterView({{.Match.ToGoStringValue}}, {{.Drop.ToGoStringValue}}, {{.Rename.ToGoStringValue}},
+			{{.ExcludeLabels.ToGoStringValue}}, {{.IncludeLabels.ToGoStringValue}}, {{.Buckets.ToGoStringValue}},
+			{{.GaugeAggregation.ToGoStringValue}}),
+{{- end }}
+	}
+	t.logErrorStack = {{.Config.Log.Reporter.ErrorStack.ToGoBoolValue}}
+	t.logLabelKeys = {{.Config.Log.Reporter.LabelKeys.ToGoStringListValue}}
+	t.logJSONBodyFrameworks = {{.Config.Log.Reporter.JSONBodyFrameworks.ToGoStringListValue}}
+	t.logContextLayout = NewLogContextLayout({{.Config.Log.Tracing.Layout.ToGoStringValue}},
+		{{.Config.Log.Tracing.Fields.Service.ToGoStringValue}}, {{.Config.Log.Tracing.Fields.Instance.ToGoStringValue}},
+		{{.Config.Log.Tracing.Fields.TraceID.ToGoStringValue}}, {{.Config.Log.Tracing.Fields.SegmentID.ToGoStringValue}},
+		{{.Config.Log.Tracing.Fields.SpanID.ToGoStringValue}}, {{.Config.Log.Tracing.Template.ToGoStringValue}})
+	t.logLimiter = NewLogReportLimiter({{.Config.Log.Reporter.MinLevel.ToGoStringValue}}, {{.Config.Log.Reporter.RateLimit.ToGoStringValue}},
+		{{.Config.Log.Reporter.SampledOnly.ToGoStringValue}}, {{.Config.Log.Reporter.AlwaysWarn.ToGoStringValue}}, t)
 	if err := t.Init(entity, rep, samp, logger, meterCollectInterval, correlation, ignoreSuffixStr, ignorePath); err != nil {
 		t.Log.Errorf("cannot initialize the SkyWalking Tracer: %v", err)
 	}
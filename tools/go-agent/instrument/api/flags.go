@@ -75,3 +75,9 @@ func (c *CompileOptions) CheckGoVersionGreaterOrEqual(requiredMajor, requiredMin
 	}
 	return false
 }
+
+// IsGoFileArg checks the compile argument at the index is a go file,
+// the package path could also end with ".go", such as "github.com/nats-io/nats.go".
+func IsGoFileArg(args []string, inx int) bool {
+	return strings.HasSuffix(args[inx], ".go") && (inx == 0 || args[inx-1] != "-p")
+}
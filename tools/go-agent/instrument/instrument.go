@@ -161,7 +161,7 @@ func parseFilesInArgs(args []string) (map[string]*fileInfo, error) {
 	}()
 	for inx, path := range args {
 		// only process the go file
-		if !strings.HasSuffix(path, ".go") {
+		if !api.IsGoFileArg(args, inx) {
 			continue
 		}
 		lastPath = path
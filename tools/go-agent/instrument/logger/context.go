@@ -50,6 +50,14 @@ type LogReporter interface {
 	GetLogContext(withEndpoint bool) interface{}
 }
 
+type LogContextFields interface {
+	GetContextFields() []string
+}
+
+type ErrorSpan interface {
+	Error(ll ...string)
+}
+
 type Entity interface {
 	GetServiceName() string
 	GetInstanceName() string
@@ -79,7 +87,11 @@ func (span *NoopSpan) GetEndPointName() string {
 }
 
 func GetLogContext(withEndpoint bool) interface{} {
-	report, ok := GetOperator().LogReporter().(LogReporter)
+	op := GetOperator()
+	if op == nil {
+		return nil
+	}
+	report, ok := op.LogReporter().(LogReporter)
 	if !ok || report == nil {
 		return nil
 	}
@@ -95,3 +107,31 @@ func GetLogContextString() string {
 
 	return stringer.String()
 }
+
+// GetLogContextFields get the key and value pairs of the tracing context which should be injected into the log,
+// the whole context is stored in the single key when the context is not split into multiple fields
+func GetLogContextFields(ctx interface{}, key string) []string {
+	if f, ok := ctx.(LogContextFields); ok {
+		if fields := f.GetContextFields(); len(fields) > 0 {
+			return fields
+		}
+	}
+	if stringer, ok := ctx.(fmt.Stringer); ok {
+		return []string{key, stringer.String()}
+	}
+	return nil
+}
+
+func RecordActiveSpanError(ll ...string) {
+	op := GetOperator()
+	if op == nil {
+		return
+	}
+	tracing, ok := op.Tracing().(TracingOperator)
+	if !ok || tracing == nil {
+		return
+	}
+	if span, ok := tracing.ActiveSpan().(ErrorSpan); ok && span != nil {
+		span.Error(ll...)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/apache/skywalking-go/plugins/core/operator"
+	"github.com/apache/skywalking-go/tools/go-agent/config"
 	"github.com/apache/skywalking-go/tools/go-agent/instrument/plugins/rewrite"
 
 	"github.com/dave/dst"
@@ -46,6 +47,9 @@ var LogReporterEnable = false
 // LogReporterLabelKeys get the reporter customized label keys
 var LogReporterLabelKeys []string
 
+// LogReporterJSONBody check the log should be reported with the JSON body
+var LogReporterJSONBody = false
+
 // LogTracingContextKey get the tracing context key
 // nolint
 var LogTracingContextKey = "SW_CTX"
@@ -60,10 +64,31 @@ func GetLogContextString() string {
 	return ""
 }
 
+// GetLogContextFields get the key and value pairs of the tracing context, such as ["SW_CTX", "[...]"]
+// or ["trace_id", "...", "span_id", "..."] when the context is split into multiple fields
+var GetLogContextFields = func(ctx interface{}, key string) []string {
+	return nil
+}
+
+// RecordActiveSpanError record the error on the active span
+func RecordActiveSpanError(ll ...string) {
+}
+
+// LoadLogVariables load the log variables from the agent core, return false if the agent core is not ready,
+// only works when the package cannot read the environment by itself
+func LoadLogVariables() bool {
+	return false
+}
+
 // ReportLog report the log to backend
 var ReportLog = func(ctx interface{}, time time.Time, level, msg string, labels map[string]string) {
 }
 
+// ReportStructuredLog report the log to backend with the JSON body which contains all the fields
+var ReportStructuredLog = func(ctx interface{}, time time.Time, level, msg string, labels map[string]string,
+	fields map[string]interface{}) {
+}
+
 type PackageConfiguration struct {
 	// needs to generate the operator helpers
 	NeedsHelpers bool
@@ -71,6 +96,30 @@ type PackageConfiguration struct {
 	NeedsVariables bool
 	// needs the change logger method
 	NeedsChangeLoggerFunc bool
+	// the package cannot import "os", so the variables are loaded from the agent core when it's ready
+	LoadVariablesFromAgent bool
+	// the package cannot import "strings", so the variables are parsed by the generated helpers
+	WithoutStringsImport bool
+	// the customized variables of the package, the key is the variable name, the value is the Go expression
+	CustomizedVariables map[string]string
+}
+
+// ValueFunctions of the package, used to generate the code for reading the configuration values
+func (p *PackageConfiguration) ValueFunctions() *config.GoValueFunctions {
+	if !p.LoadVariablesFromAgent && !p.WithoutStringsImport {
+		return config.DefaultGoValueFunctions
+	}
+	funcs := *config.DefaultGoValueFunctions
+	if p.LoadVariablesFromAgent {
+		// the environment value could only be read through the agent core
+		funcs.GetEnv = "getEnvValue"
+	}
+	if p.WithoutStringsImport {
+		// the values could only be parsed by the generated helpers
+		funcs.EqualFold = "equalFoldValue"
+		funcs.Split = "splitValue"
+	}
+	return &funcs
 }
 
 type LogFramework interface {
@@ -41,16 +41,14 @@ func Wrap(base logrus.Formatter, contextKey string) *WrapFormat {
 
 // Format logging with trace context
 func (format *WrapFormat) Format(entry *logrus.Entry) ([]byte, error) {
-	var logContext fmt.Stringer
-	keys := LogReporterLabelKeys
+	var logContext interface{}
+	keys, jsonBody := LogReporterLabelKeys, LogReporterJSONBody
 	if LogReporterEnable {
 		ctx := GetLogContext(true)
 		if ctx == nil {
 			return format.Base.Format(entry)
 		}
-		if stringer, ok := ctx.(fmt.Stringer); ok {
-			logContext = stringer
-		}
+		logContext = ctx
 		labels := make(map[string]string, len(keys))
 		for _, key := range keys {
 			for k, v := range entry.Data {
@@ -59,13 +57,19 @@ func (format *WrapFormat) Format(entry *logrus.Entry) ([]byte, error) {
 				}
 			}
 		}
-		ReportLog(ctx, entry.Time, entry.Level.String(), entry.Message, labels)
+		if jsonBody {
+			ReportStructuredLog(ctx, entry.Time, entry.Level.String(), entry.Message, labels, entry.Data)
+		} else {
+			ReportLog(ctx, entry.Time, entry.Level.String(), entry.Message, labels)
+		}
 	}
 	// append trace context
 	if logContext == nil {
-		entry.Data[format.traceContextKey] = GetLogContextString()
-	} else {
-		entry.Data[format.traceContextKey] = logContext.String()
+		logContext = GetLogContext(false)
+	}
+	fields := GetLogContextFields(logContext, format.traceContextKey)
+	for i := 0; i+1 < len(fields); i += 2 {
+		entry.Data[fields[i]] = fields[i+1]
 	}
 
 	return format.Base.Format(entry)
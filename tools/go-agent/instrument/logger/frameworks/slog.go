@@ -0,0 +1,118 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/apache/skywalking-go/tools/go-agent/instrument/plugins/rewrite"
+	"github.com/apache/skywalking-go/tools/go-agent/tools"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/dstutil"
+)
+
+const slogPackagePath = "log/slog"
+
+type Slog struct {
+}
+
+func NewSlog() *Slog {
+	return &Slog{}
+}
+
+func (s *Slog) Name() string {
+	return "slog"
+}
+
+func (s *Slog) PackagePaths() map[string]*PackageConfiguration {
+	return map[string]*PackageConfiguration{
+		slogPackagePath: {NeedsHelpers: true, NeedsVariables: true, NeedsChangeLoggerFunc: true, LoadVariablesFromAgent: true},
+	}
+}
+
+func (s *Slog) AutomaticBindFunctions(fun *dst.FuncDecl) string {
+	return ""
+}
+
+func (s *Slog) GenerateExtraFiles(pkgPath, debugDir string) ([]*rewrite.FileInfo, error) {
+	return []*rewrite.FileInfo{
+		s.generateReWriteFile("slog_adapt.go", debugDir),
+		s.generateReWriteFile("slog_handler.go", debugDir),
+	}, nil
+}
+
+func (s *Slog) generateReWriteFile(name, debugDir string) *rewrite.FileInfo {
+	file, err := FrameworkFS.ReadFile(name)
+	if err != nil {
+		panic(fmt.Errorf("get slog file error: %v", err))
+	}
+
+	if debugDir == "" {
+		return rewrite.NewFile("slog", name, string(file))
+	}
+	return rewrite.NewFileWithDebug("slog", name, string(file),
+		filepath.Join(debugDir, "tools", "go-agent", "instrument", "logger", "frameworks"))
+}
+
+func (s *Slog) CustomizedEnhance(path string, curFile *dst.File, cursor *dstutil.Cursor, allFiles []*dst.File) (map[string]string, bool) {
+	n, ok := cursor.Node().(*dst.FuncDecl)
+	if !ok || n.Recv != nil {
+		return nil, false
+	}
+
+	// wrap the handler when creating the logger, the default logger is also created by this function
+	if n.Name.Name == "New" && n.Type.Params != nil && len(n.Type.Params.List) == 1 &&
+		tools.GenerateTypeNameByExp(n.Type.Params.List[0].Type) == "Handler" &&
+		n.Type.Results != nil && len(n.Type.Results.List) == 1 &&
+		tools.GenerateTypeNameByExp(n.Type.Results.List[0].Type) == "*Logger" {
+		parameters := tools.EnhanceParameterNames(n.Type.Params, tools.FieldListTypeParam)
+		results := tools.EnhanceParameterNames(n.Type.Results, tools.FieldListTypeResult)
+		return s.enhanceMethod(n, fmt.Sprintf(`if %[1]s != nil {
+if _, isDefault := %[1]s.(*defaultHandler); !isDefault { defer func() { %[3]sSlogUpdateSlogLogger(%[2]s) }() };
+%[1]s = %[3]sSlogWrapHandler(%[1]s) }`,
+			parameters[0].Name, results[0].Name, rewrite.StaticMethodPrefix)), true
+	}
+
+	// the default handler must not be redirected to the log package, otherwise it would be deadlock
+	if n.Name.Name == "SetDefault" && n.Type.Params != nil && len(n.Type.Params.List) == 1 &&
+		tools.GenerateTypeNameByExp(n.Type.Params.List[0].Type) == "*Logger" {
+		parameters := tools.EnhanceParameterNames(n.Type.Params, tools.FieldListTypeParam)
+		return s.enhanceMethod(n, fmt.Sprintf(`if %[1]s != nil {
+if _, isDefault := %[2]sSlogUnwrapHandler(%[1]s.Handler()).(*defaultHandler); isDefault { defaultLogger.Store(%[1]s); return } }`,
+			parameters[0].Name, rewrite.StaticMethodPrefix)), true
+	}
+	return nil, false
+}
+
+func (s *Slog) enhanceMethod(fun *dst.FuncDecl, goCode string) map[string]string {
+	funcID := tools.BuildFuncIdentity(slogPackagePath, fun)
+	replaceKey := fmt.Sprintf("//goagent:enhance_%s", funcID)
+	fun.Body.Decs.Lbrace.Prepend("\n", replaceKey)
+
+	return map[string]string{replaceKey: goCode}
+}
+
+func (s *Slog) InitFunctions() []*dst.FuncDecl {
+	return nil
+}
+
+func (s *Slog) InitImports() []*dst.ImportSpec {
+	return nil
+}
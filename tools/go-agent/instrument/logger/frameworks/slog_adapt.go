@@ -0,0 +1,63 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+func UpdateSlogLogger(l *slog.Logger) {
+	ChangeLogger(NewSlogAdapter(l))
+}
+
+type SlogAdapter struct {
+	log *slog.Logger
+}
+
+func NewSlogAdapter(log *slog.Logger) *SlogAdapter {
+	return &SlogAdapter{log: log}
+}
+
+func (l *SlogAdapter) WithField(key string, value interface{}) interface{} {
+	return NewSlogAdapter(l.log.With(key, value))
+}
+
+func (l *SlogAdapter) Info(args ...interface{}) {
+	l.log.Info(fmt.Sprint(args...))
+}
+
+func (l *SlogAdapter) Infof(format string, args ...interface{}) {
+	l.log.Info(fmt.Sprintf(format, args...))
+}
+
+func (l *SlogAdapter) Warn(args ...interface{}) {
+	l.log.Warn(fmt.Sprint(args...))
+}
+
+func (l *SlogAdapter) Warnf(format string, args ...interface{}) {
+	l.log.Warn(fmt.Sprintf(format, args...))
+}
+
+func (l *SlogAdapter) Error(args ...interface{}) {
+	l.log.Error(fmt.Sprint(args...))
+}
+
+func (l *SlogAdapter) Errorf(format string, args ...interface{}) {
+	l.log.Error(fmt.Sprintf(format, args...))
+}
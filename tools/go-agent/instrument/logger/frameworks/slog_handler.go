@@ -0,0 +1,180 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// TracingHandler is wrap handler to transmit trace context and report the log when logging
+type TracingHandler struct {
+	base   slog.Handler
+	groups []string
+	// the attributes from WithAttrs, the key is combined with group names
+	attrs map[string]slog.Value
+}
+
+// WrapHandler wrap the original handler, the wrapped handler would not be wrapped again
+func WrapHandler(base slog.Handler) slog.Handler {
+	if _, wrapped := base.(*TracingHandler); wrapped {
+		return base
+	}
+	return &TracingHandler{base: base}
+}
+
+// UnwrapHandler get the original handler if the handler has been wrapped
+func UnwrapHandler(h slog.Handler) slog.Handler {
+	if wrapped, ok := h.(*TracingHandler); ok {
+		return wrapped.base
+	}
+	return h
+}
+
+func (h *TracingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.base.Enabled(ctx, level)
+}
+
+// Handle logging with trace context
+func (h *TracingHandler) Handle(ctx context.Context, record slog.Record) error {
+	if !LoadLogVariables() {
+		return h.base.Handle(ctx, record)
+	}
+	reporterEnable, tracingContextEnable := LogReporterEnable, LogTracingContextEnable
+	if !reporterEnable && !tracingContextEnable {
+		return h.base.Handle(ctx, record)
+	}
+	logContext := GetLogContext(reporterEnable)
+	if logContext == nil {
+		return h.base.Handle(ctx, record)
+	}
+	if reporterEnable {
+		logTime := record.Time
+		if logTime.IsZero() {
+			logTime = time.Now()
+		}
+		if jsonBody := LogReporterJSONBody; jsonBody {
+			labels, fields := h.fields(&record, true)
+			ReportStructuredLog(logContext, logTime, record.Level.String(), record.Message, labels, fields)
+		} else {
+			labels, _ := h.fields(&record, false)
+			ReportLog(logContext, logTime, record.Level.String(), record.Message, labels)
+		}
+	}
+	// append trace context
+	if tracingContextEnable {
+		if fields := GetLogContextFields(logContext, LogTracingContextKey); len(fields) > 0 {
+			record = record.Clone()
+			for i := 0; i+1 < len(fields); i += 2 {
+				record.AddAttrs(slog.String(fields[i], fields[i+1]))
+			}
+		}
+	}
+	return h.base.Handle(ctx, record)
+}
+
+func (h *TracingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	result := &TracingHandler{
+		base:   h.base.WithAttrs(attrs),
+		groups: h.groups,
+		attrs:  make(map[string]slog.Value, len(h.attrs)+len(attrs)),
+	}
+	for k, v := range h.attrs {
+		result.attrs[k] = v
+	}
+	prefix := slogGroupPrefix(h.groups)
+	for _, attr := range attrs {
+		visitSlogAttr(prefix, attr, func(key string, value slog.Value) {
+			result.attrs[key] = value
+		})
+	}
+	return result
+}
+
+func (h *TracingHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	groups := make([]string, 0, len(h.groups)+1)
+	groups = append(groups, h.groups...)
+	return &TracingHandler{
+		base:   h.base.WithGroup(name),
+		groups: append(groups, name),
+		attrs:  h.attrs,
+	}
+}
+
+// fields get the labels of the log, and all the fields when the log is reported with the JSON body
+func (h *TracingHandler) fields(record *slog.Record, all bool) (labels map[string]string, fields map[string]interface{}) {
+	keys := LogReporterLabelKeys
+	labels = make(map[string]string, len(keys))
+	if all {
+		fields = make(map[string]interface{}, len(h.attrs)+record.NumAttrs())
+	} else if len(keys) == 0 {
+		return labels, nil
+	}
+	appendField := func(key string, value slog.Value) {
+		for _, k := range keys {
+			if k == key {
+				labels[key] = value.String()
+			}
+		}
+		if all {
+			fields[key] = value.Any()
+		}
+	}
+	for k, v := range h.attrs {
+		appendField(k, v)
+	}
+	prefix := slogGroupPrefix(h.groups)
+	record.Attrs(func(attr slog.Attr) bool {
+		visitSlogAttr(prefix, attr, appendField)
+		return true
+	})
+	return labels, fields
+}
+
+func slogGroupPrefix(groups []string) string {
+	var prefix string
+	for _, g := range groups {
+		prefix += g + "."
+	}
+	return prefix
+}
+
+func visitSlogAttr(prefix string, attr slog.Attr, visitor func(key string, value slog.Value)) {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		// the group attributes with empty key should be inline
+		if attr.Key != "" {
+			prefix += attr.Key + "."
+		}
+		for _, a := range value.Group() {
+			visitSlogAttr(prefix, a, visitor)
+		}
+		return
+	}
+	if attr.Key == "" {
+		return
+	}
+	visitor(prefix+attr.Key, value)
+}
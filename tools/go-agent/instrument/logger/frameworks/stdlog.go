@@ -0,0 +1,113 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/apache/skywalking-go/tools/go-agent/config"
+	"github.com/apache/skywalking-go/tools/go-agent/instrument/plugins/rewrite"
+	"github.com/apache/skywalking-go/tools/go-agent/tools"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/dstutil"
+)
+
+const stdLogPackagePath = "log"
+
+// StdLogEnable check the standard library log capture enable
+var StdLogEnable = false
+
+// StdLogContextPrefix check prefix the tracing context to the log line
+var StdLogContextPrefix = false
+
+// StdLogLevel get the level of the log line when reporting
+var StdLogLevel = "INFO"
+
+type StdLog struct {
+}
+
+func NewStdLog() *StdLog {
+	return &StdLog{}
+}
+
+func (s *StdLog) Name() string {
+	return "stdlog"
+}
+
+func (s *StdLog) PackagePaths() map[string]*PackageConfiguration {
+	stdConfig := config.GetConfig().Log.Std
+	packageConfig := &PackageConfiguration{NeedsHelpers: true, NeedsVariables: true, WithoutStringsImport: true}
+	valueFuncs := packageConfig.ValueFunctions()
+	packageConfig.CustomizedVariables = map[string]string{
+		"StdLogEnable":        stdConfig.Enabled.ToGoBoolValueBy(valueFuncs),
+		"StdLogContextPrefix": stdConfig.ContextPrefix.ToGoBoolValueBy(valueFuncs),
+		"StdLogLevel":         stdConfig.Level.ToGoStringValueBy(valueFuncs),
+	}
+	return map[string]*PackageConfiguration{stdLogPackagePath: packageConfig}
+}
+
+func (s *StdLog) AutomaticBindFunctions(fun *dst.FuncDecl) string {
+	return ""
+}
+
+func (s *StdLog) GenerateExtraFiles(pkgPath, debugDir string) ([]*rewrite.FileInfo, error) {
+	file, err := FrameworkFS.ReadFile("stdlog_output.go")
+	if err != nil {
+		panic(fmt.Errorf("get stdlog file error: %v", err))
+	}
+
+	if debugDir == "" {
+		return []*rewrite.FileInfo{rewrite.NewFile("log", "stdlog_output.go", string(file))}, nil
+	}
+	return []*rewrite.FileInfo{rewrite.NewFileWithDebug("log", "stdlog_output.go", string(file),
+		filepath.Join(debugDir, "tools", "go-agent", "instrument", "logger", "frameworks"))}, nil
+}
+
+func (s *StdLog) CustomizedEnhance(path string, curFile *dst.File, cursor *dstutil.Cursor, allFiles []*dst.File) (map[string]string, bool) {
+	n, ok := cursor.Node().(*dst.FuncDecl)
+	if !ok {
+		return nil, false
+	}
+	// all the lines are written through "func (l *Logger) output(pc uintptr, calldepth int, appendOutput func([]byte) []byte) error",
+	// the pc is only provided by the log/slog default handler, which has already been handled by the slog framework
+	if n.Recv != nil && len(n.Recv.List) == 1 && tools.GenerateTypeNameByExp(n.Recv.List[0].Type) == "*Logger" &&
+		n.Name.Name == "output" && n.Type.Params != nil && len(n.Type.Params.List) == 3 &&
+		tools.GenerateTypeNameByExp(n.Type.Params.List[0].Type) == "uintptr" &&
+		tools.GenerateTypeNameByExp(n.Type.Params.List[1].Type) == "int" {
+		recvs := tools.EnhanceParameterNames(n.Recv, tools.FieldListTypeRecv)
+		parameters := tools.EnhanceParameterNames(n.Type.Params, tools.FieldListTypeParam)
+		funcID := tools.BuildFuncIdentity(stdLogPackagePath, n)
+		replaceKey := fmt.Sprintf("//goagent:enhance_%s", funcID)
+		n.Body.Decs.Lbrace.Prepend("\n", replaceKey)
+		return map[string]string{
+			replaceKey: fmt.Sprintf("if %[2]s == 0 && !%[1]s.isDiscard.Load() { %[4]s = %[5]sLogWrapOutput(%[1]s.Writer(), %[3]s, %[4]s) }",
+				recvs[0].Name, parameters[0].Name, parameters[1].Name, parameters[2].Name, rewrite.StaticMethodPrefix),
+		}, true
+	}
+	return nil, false
+}
+
+func (s *StdLog) InitFunctions() []*dst.FuncDecl {
+	return nil
+}
+
+func (s *StdLog) InitImports() []*dst.ImportSpec {
+	return nil
+}
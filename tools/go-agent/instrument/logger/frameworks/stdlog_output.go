@@ -0,0 +1,108 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"time"
+)
+
+// the writer of the logger when log/slog redirects the log package to the default handler
+const stdLogSlogWriterType = "*slog.handlerWriter"
+
+// WrapOutput report the line and prefix the tracing context when the logger appending the line,
+// the calldepth is the same as the log package, which points to the log method
+func WrapOutput(out io.Writer, calldepth int, appendOutput func([]byte) []byte) func([]byte) []byte {
+	captureEnable, reporterEnable, jsonBody := StdLogEnable, LogReporterEnable, LogReporterJSONBody
+	tracingContextEnable, contextPrefix := LogTracingContextEnable, StdLogContextPrefix
+	prefixEnable := tracingContextEnable && contextPrefix
+	if !captureEnable || (!reporterEnable && !prefixEnable) {
+		return appendOutput
+	}
+	// the line would be handled by the slog framework
+	if fmt.Sprintf("%T", out) == stdLogSlogWriterType {
+		return appendOutput
+	}
+	ctx := GetLogContext(reporterEnable)
+	if ctx == nil {
+		return appendOutput
+	}
+	level, isError := StdLogLevel, false
+	pcs := make([]uintptr, 1)
+	if runtime.Callers(calldepth+1, pcs) > 0 {
+		frame, _ := runtime.CallersFrames(pcs).Next()
+		level, isError = stdLogLevel(frame.Function, level)
+	}
+	contextKey := LogTracingContextKey
+	return func(b []byte) []byte {
+		if prefixEnable {
+			b = appendStdLogContext(b, GetLogContextFields(ctx, contextKey), contextKey)
+		}
+		start := len(b)
+		b = appendOutput(b)
+		msg := string(b[start:])
+		if len(msg) > 0 && msg[len(msg)-1] == '\n' {
+			msg = msg[:len(msg)-1]
+		}
+		if reporterEnable && jsonBody {
+			ReportStructuredLog(ctx, time.Now(), level, msg, nil, nil)
+		} else if reporterEnable {
+			ReportLog(ctx, time.Now(), level, msg, nil)
+		}
+		if isError {
+			RecordActiveSpanError("event", "error", "level", level, "message", msg)
+		}
+		return b
+	}
+}
+
+// prefix the whole context as "SW_CTX: [...] ", or each field as "trace_id=... " when the context is split
+func appendStdLogContext(b []byte, fields []string, contextKey string) []byte {
+	if len(fields) == 2 && fields[0] == contextKey {
+		b = append(b, contextKey...)
+		b = append(b, ": "...)
+		b = append(b, fields[1]...)
+		return append(b, ' ')
+	}
+	for i := 0; i+1 < len(fields); i += 2 {
+		b = append(b, fields[i]...)
+		b = append(b, '=')
+		b = append(b, fields[i+1]...)
+		b = append(b, ' ')
+	}
+	return b
+}
+
+// the lines written by the Panic and Fatal methods are errors
+func stdLogLevel(name, defaultLevel string) (level string, isError bool) {
+	for i := len(name) - 1; i >= 0; i-- {
+		if name[i] == '.' {
+			name = name[i+1:]
+			break
+		}
+	}
+	switch name {
+	case "Panic", "Panicf", "Panicln":
+		return "PANIC", true
+	case "Fatal", "Fatalf", "Fatalln":
+		return "FATAL", true
+	}
+	return defaultLevel, false
+}
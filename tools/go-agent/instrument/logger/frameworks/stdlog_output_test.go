@@ -0,0 +1,172 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testLogContext struct {
+	fields []string
+}
+
+func (c *testLogContext) String() string {
+	return "[test-context]"
+}
+
+type reportedLine struct {
+	level string
+	msg   string
+}
+
+// testLogger writes the lines the same way as the log package,
+// the calldepth of output points to the exported log method
+type testLogger struct {
+	out io.Writer
+	buf []byte
+}
+
+//go:noinline
+func (l *testLogger) output(calldepth int, msg string) {
+	appendOutput := WrapOutput(l.out, calldepth, func(b []byte) []byte {
+		return append(b, msg+"\n"...)
+	})
+	l.buf = appendOutput(l.buf[:0])
+}
+
+//go:noinline
+func (l *testLogger) Print(msg string) {
+	l.output(2, msg)
+}
+
+//go:noinline
+func (l *testLogger) Panicf(msg string) {
+	l.output(2, msg)
+}
+
+//go:noinline
+func (l *testLogger) Fatalln(msg string) {
+	l.output(2, msg)
+}
+
+func setupStdLogTest(t *testing.T) *[]reportedLine {
+	oldValues := []bool{StdLogEnable, StdLogContextPrefix, LogReporterEnable, LogReporterJSONBody, LogTracingContextEnable}
+	oldLevel, oldGetContext, oldGetFields, oldReport := StdLogLevel, GetLogContext, GetLogContextFields, ReportLog
+	t.Cleanup(func() {
+		StdLogEnable, StdLogContextPrefix, LogReporterEnable, LogReporterJSONBody, LogTracingContextEnable =
+			oldValues[0], oldValues[1], oldValues[2], oldValues[3], oldValues[4]
+		StdLogLevel, GetLogContext, GetLogContextFields, ReportLog = oldLevel, oldGetContext, oldGetFields, oldReport
+	})
+
+	StdLogEnable, StdLogContextPrefix, LogReporterEnable, LogReporterJSONBody, LogTracingContextEnable = true, true, true, false, true
+	StdLogLevel = "INFO"
+	GetLogContext = func(withEndpoint bool) interface{} {
+		return &testLogContext{}
+	}
+	GetLogContextFields = func(ctx interface{}, key string) []string {
+		c := ctx.(*testLogContext)
+		if len(c.fields) > 0 {
+			return c.fields
+		}
+		return []string{key, c.String()}
+	}
+	reported := make([]reportedLine, 0)
+	ReportLog = func(ctx interface{}, time time.Time, level, msg string, labels map[string]string) {
+		reported = append(reported, reportedLine{level: level, msg: msg})
+	}
+	return &reported
+}
+
+func TestStdLogLevel(t *testing.T) {
+	tests := []struct {
+		function string
+		level    string
+		isError  bool
+	}{
+		{"log.Printf", "INFO", false},
+		{"log.(*Logger).Println", "INFO", false},
+		{"log.Panic", "PANIC", true},
+		{"log.(*Logger).Panicf", "PANIC", true},
+		{"log.Panicln", "PANIC", true},
+		{"log.Fatal", "FATAL", true},
+		{"log.(*Logger).Fatalf", "FATAL", true},
+		{"log.Fatalln", "FATAL", true},
+		{"main.Panic.func1", "INFO", false},
+	}
+	for _, tt := range tests {
+		level, isError := stdLogLevel(tt.function, "INFO")
+		assert.Equal(t, tt.level, level, tt.function)
+		assert.Equal(t, tt.isError, isError, tt.function)
+	}
+}
+
+func TestWrapOutputLevelFromCallers(t *testing.T) {
+	reported := setupStdLogTest(t)
+	logger := &testLogger{out: &bytes.Buffer{}}
+
+	logger.Print("print line")
+	assert.Equal(t, "SW_CTX: [test-context] print line\n", string(logger.buf))
+	logger.Panicf("panic line")
+	logger.Fatalln("fatal line")
+
+	assert.Equal(t, []reportedLine{
+		{level: "INFO", msg: "print line"},
+		{level: "PANIC", msg: "panic line"},
+		{level: "FATAL", msg: "fatal line"},
+	}, *reported)
+}
+
+func TestWrapOutputDisabled(t *testing.T) {
+	reported := setupStdLogTest(t)
+	logger := &testLogger{out: &bytes.Buffer{}}
+
+	StdLogEnable = false
+	logger.Print("not captured")
+	assert.Equal(t, "not captured\n", string(logger.buf))
+
+	// only prefix the tracing context when the reporter is disabled
+	StdLogEnable, LogReporterEnable = true, false
+	logger.Print("prefix only")
+	assert.Equal(t, "SW_CTX: [test-context] prefix only\n", string(logger.buf))
+
+	// no tracing context
+	LogReporterEnable = true
+	GetLogContext = func(withEndpoint bool) interface{} {
+		return nil
+	}
+	logger.Print("no context")
+	assert.Equal(t, "no context\n", string(logger.buf))
+	assert.Empty(t, *reported)
+}
+
+func TestWrapOutputSplitLayout(t *testing.T) {
+	reported := setupStdLogTest(t)
+	GetLogContext = func(withEndpoint bool) interface{} {
+		return &testLogContext{fields: []string{"trace_id", "t1", "span_id", "0"}}
+	}
+	logger := &testLogger{out: &bytes.Buffer{}}
+
+	logger.Print("split line")
+	assert.Equal(t, "trace_id=t1 span_id=0 split line\n", string(logger.buf))
+	assert.Equal(t, []reportedLine{{level: "INFO", msg: "split line"}}, *reported)
+}
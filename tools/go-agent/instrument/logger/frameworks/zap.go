@@ -114,8 +114,8 @@ func (z *Zap) CustomizedEnhance(path string, curFile *dst.File, cursor *dstutil.
 			st.Fields.List = append(st.Fields.List,
 				// tracing context object
 				&dst.Field{Names: []*dst.Ident{dst.NewIdent("SWContext")}, Type: dst.NewIdent("interface{}")},
-				// tracing context field
-				&dst.Field{Names: []*dst.Ident{dst.NewIdent("SWContextField")}, Type: &dst.StarExpr{X: dst.NewIdent("Field")}},
+				// tracing context fields
+				&dst.Field{Names: []*dst.Ident{dst.NewIdent("SWContextFields")}, Type: &dst.ArrayType{Elt: dst.NewIdent("Field")}},
 				// existing fields needs to be added into, such as generate from log.With("key", "value")
 				&dst.Field{Names: []*dst.Ident{dst.NewIdent("SWFields")}, Type: &dst.ArrayType{Elt: dst.NewIdent("Field")}},
 			)
@@ -125,6 +125,7 @@ func (z *Zap) CustomizedEnhance(path string, curFile *dst.File, cursor *dstutil.
 					&dst.ValueSpec{Names: []*dst.Ident{
 						dst.NewIdent("SWReporterEnable"),
 						dst.NewIdent("SWLogEnable"),
+						dst.NewIdent("SWReporterJSONBody"),
 					}, Type: dst.NewIdent("bool")},
 					&dst.ValueSpec{Names: []*dst.Ident{
 						dst.NewIdent("SWReporterLabelKeys"),
@@ -158,13 +159,14 @@ func (z *Zap) CustomizedEnhance(path string, curFile *dst.File, cursor *dstutil.
 zapcore.SWReporterEnable = %s
 zapcore.SWReporterLabelKeys = %s
 zapcore.SWLogEnable = %s
-}`, "LogReporterEnable", "LogReporterLabelKeys", "LogTracingContextEnable"))[0].(*dst.FuncDecl)
+zapcore.SWReporterJSONBody = %s
+}`, "LogReporterEnable", "LogReporterLabelKeys", "LogTracingContextEnable", "LogReporterJSONBody"))[0].(*dst.FuncDecl)
 			z.initImports = []*dst.ImportSpec{
 				{Path: &dst.BasicLit{Kind: token.STRING, Value: `"go.uber.org/zap/zapcore"`}},
 			}
 
 			return z.enhanceMethod(n, fmt.Sprintf("defer func() {if %s != nil {"+
-				"%s.SWContext, %s.SWContextField = %s%s(%s); %s.SWFields = %s.SWFields;}}()", entryName,
+				"%s.SWContext, %s.SWContextFields = %s%s(%s); %s.SWFields = %s.SWFields;}}()", entryName,
 				entryName, entryName, rewrite.StaticMethodPrefix, "ZapTracingContextEnhance", entryName, entryName, recvName)), true
 		}
 
@@ -186,7 +188,7 @@ zapcore.SWLogEnable = %s
 			tools.GenerateTypeNameByExp(n.Type.Params.List[0].Type) == "[]Field" {
 			recvs := tools.EnhanceParameterNames(n.Recv, tools.FieldListTypeRecv)
 			parameters := tools.EnhanceParameterNames(n.Type.Params, tools.FieldListTypeParam)
-			return z.enhanceMethod(n, fmt.Sprintf(`if %s != nil { %s = %sZapcore%s(%s, %s, %s.SWFields, %s.SWContext, %s.SWContextField, SWReporterEnable, SWLogEnable, SWReporterLabelKeys) }`,
+			return z.enhanceMethod(n, fmt.Sprintf(`if %s != nil { %s = %sZapcore%s(%s, %s, %s.SWFields, %s.SWContext, %s.SWContextFields, SWReporterEnable, SWLogEnable, SWReporterJSONBody, SWReporterLabelKeys) }`,
 				recvs[0].Name, parameters[0].Name, rewrite.StaticMethodPrefix, "ReportLogFromZapEntry", recvs[0].Name,
 				parameters[0].Name, recvs[0].Name, recvs[0].Name, recvs[0].Name)), true
 		}
@@ -24,7 +24,7 @@ import (
 )
 
 func ReportLogFromZapEntry(entry *zapcore.CheckedEntry, fields, needs []zapcore.Field, tracingContext interface{},
-	tracingContextField *zapcore.Field, reporterEnable, logEnable bool, reportLabelsKeys []string) []zapcore.Field {
+	tracingContextFields []zapcore.Field, reporterEnable, logEnable, jsonBody bool, reportLabelsKeys []string) []zapcore.Field {
 	if reporterEnable && tracingContext != nil {
 		labels := make(map[string]string, len(reportLabelsKeys))
 		for _, key := range reportLabelsKeys {
@@ -36,19 +36,44 @@ func ReportLogFromZapEntry(entry *zapcore.CheckedEntry, fields, needs []zapcore.
 				}
 			}
 		}
-		for _, f := range needs {
-			if k, v := generateLabelKeyValueFromField(f); k != "" {
-				labels[k] = v
+		// the existing fields contain all the fields when reporting with the JSON body
+		for _, key := range reportLabelsKeys {
+			for _, f := range needs {
+				if f.Key == key {
+					if k, v := generateLabelKeyValueFromField(f); k != "" {
+						labels[k] = v
+					}
+				}
 			}
 		}
-		ReportLog(tracingContext, entry.Time, entry.Level.String(), entry.Message, labels)
+		if jsonBody {
+			ReportStructuredLog(tracingContext, entry.Time, entry.Level.String(), entry.Message, labels,
+				generateStructuredFields(needs, fields))
+		} else {
+			ReportLog(tracingContext, entry.Time, entry.Level.String(), entry.Message, labels)
+		}
 	}
-	if logEnable && tracingContextField != nil {
-		fields = append(fields, *tracingContextField)
+	if logEnable && len(tracingContextFields) > 0 {
+		fields = append(fields, tracingContextFields...)
 	}
 	return fields
 }
 
+// generateStructuredFields keep the type of the fields, the errors are kept for serializing with the stack
+func generateStructuredFields(fieldsList ...[]zapcore.Field) map[string]interface{} {
+	encoder := zapcore.NewMapObjectEncoder()
+	for _, fields := range fieldsList {
+		for _, f := range fields {
+			if err, ok := f.Interface.(error); ok && f.Type == zapcore.ErrorType {
+				_ = encoder.AddReflected(f.Key, err)
+				continue
+			}
+			f.AddTo(encoder)
+		}
+	}
+	return encoder.Fields
+}
+
 func generateLabelKeyValueFromField(field zapcore.Field) (key, value string) {
 	if field.Interface != nil {
 		return field.Key, fmt.Sprintf("%v", field.Interface)
@@ -35,21 +35,31 @@ func UpdateZapLogger(l *zap.Logger) {
 	ChangeLogger(NewZapAdapter(l))
 }
 
-func TracingContextEnhance(entry *zapcore.CheckedEntry) (interface{}, *zapcore.Field) {
+func TracingContextEnhance(entry *zapcore.CheckedEntry) (interface{}, []zapcore.Field) {
 	var getEndpoint = LogReporterEnable
 	if LogReporterEnable || LogTracingContextEnable {
 		ctx := GetLogContext(getEndpoint)
-		if c, ok := ctx.(fmt.Stringer); ok {
-			f := zap.String(LogTracingContextKey, c.String())
-			return ctx, &f
+		if ctx == nil {
+			return nil, nil
 		}
+		kvs := GetLogContextFields(ctx, LogTracingContextKey)
+		fields := make([]zapcore.Field, 0, len(kvs)/2)
+		for i := 0; i+1 < len(kvs); i += 2 {
+			fields = append(fields, zap.String(kvs[i], kvs[i+1]))
+		}
+		return ctx, fields
 	}
 	return nil, nil
 }
 
 func KnownFieldFilter(fs, existingFields []zapcore.Field) []zapcore.Field {
-	keys := LogReporterLabelKeys
+	keys, jsonBody := LogReporterLabelKeys, LogReporterJSONBody
 	res := make([]zapcore.Field, 0)
+	if jsonBody {
+		// all the fields are needed when reporting with the JSON body
+		res = append(res, existingFields...)
+		return append(res, fs...)
+	}
 	for _, k := range keys {
 		for _, f := range fs {
 			if f.Key == k {
@@ -0,0 +1,100 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/apache/skywalking-go/tools/go-agent/instrument/plugins/rewrite"
+	"github.com/apache/skywalking-go/tools/go-agent/tools"
+
+	"github.com/dave/dst"
+	"github.com/dave/dst/dstutil"
+)
+
+const zerologPackagePath = "github.com/rs/zerolog"
+
+type Zerolog struct {
+}
+
+func NewZerolog() *Zerolog {
+	return &Zerolog{}
+}
+
+func (z *Zerolog) Name() string {
+	return "zerolog"
+}
+
+func (z *Zerolog) PackagePaths() map[string]*PackageConfiguration {
+	return map[string]*PackageConfiguration{
+		zerologPackagePath: {NeedsHelpers: true, NeedsVariables: true, NeedsChangeLoggerFunc: true},
+	}
+}
+
+func (z *Zerolog) AutomaticBindFunctions(fun *dst.FuncDecl) string {
+	// enhance zerolog.New(), update the logger when getting new instance
+	if fun.Recv == nil && fun.Name.Name == "New" && fun.Type.Results != nil && len(fun.Type.Results.List) == 1 &&
+		tools.GenerateTypeNameByExp(fun.Type.Results.List[0].Type) == "Logger" {
+		return rewrite.StaticMethodPrefix + "ZerologUpdateZerologLogger(*ret_0)"
+	}
+	return ""
+}
+
+func (z *Zerolog) GenerateExtraFiles(pkgPath, debugDir string) ([]*rewrite.FileInfo, error) {
+	file, err := FrameworkFS.ReadFile("zerolog_adapt.go")
+	if err != nil {
+		panic(fmt.Errorf("get zerolog file error: %v", err))
+	}
+
+	if debugDir == "" {
+		return []*rewrite.FileInfo{rewrite.NewFile("zerolog", "zerolog_adapt.go", string(file))}, nil
+	}
+	return []*rewrite.FileInfo{rewrite.NewFileWithDebug("zerolog", "zerolog_adapt.go", string(file),
+		filepath.Join(debugDir, "tools", "go-agent", "instrument", "logger", "frameworks"))}, nil
+}
+
+func (z *Zerolog) CustomizedEnhance(path string, curFile *dst.File, cursor *dstutil.Cursor, allFiles []*dst.File) (map[string]string, bool) {
+	n, ok := cursor.Node().(*dst.FuncDecl)
+	if !ok {
+		return nil, false
+	}
+	// enhance the method which write the event, all the fields(include the logger context) are already in the buffer
+	if n.Recv != nil && len(n.Recv.List) == 1 && tools.GenerateTypeNameByExp(n.Recv.List[0].Type) == "*Event" &&
+		n.Name.Name == "msg" && n.Type.Params != nil && len(n.Type.Params.List) == 1 &&
+		tools.GenerateTypeNameByExp(n.Type.Params.List[0].Type) == "string" {
+		recvs := tools.EnhanceParameterNames(n.Recv, tools.FieldListTypeRecv)
+		parameters := tools.EnhanceParameterNames(n.Type.Params, tools.FieldListTypeParam)
+		funcID := tools.BuildFuncIdentity(zerologPackagePath, n)
+		replaceKey := fmt.Sprintf("//goagent:enhance_%s", funcID)
+		n.Body.Decs.Lbrace.Prepend("\n", replaceKey)
+		return map[string]string{
+			replaceKey: fmt.Sprintf("if %s != nil { %sZerologEnhanceEvent(%s, %s.level, %s, %s.buf, LevelFieldName) }",
+				recvs[0].Name, rewrite.StaticMethodPrefix, recvs[0].Name, recvs[0].Name, parameters[0].Name, recvs[0].Name),
+		}, true
+	}
+	return nil, false
+}
+
+func (z *Zerolog) InitFunctions() []*dst.FuncDecl {
+	return nil
+}
+
+func (z *Zerolog) InitImports() []*dst.ImportSpec {
+	return nil
+}
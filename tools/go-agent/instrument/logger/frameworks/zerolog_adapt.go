@@ -0,0 +1,136 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func UpdateZerologLogger(l zerolog.Logger) {
+	ChangeLogger(NewZerologAdapter(l))
+}
+
+// EnhanceEvent report the event and append the tracing context before writing
+func EnhanceEvent(e *zerolog.Event, level zerolog.Level, msg string, buf []byte, levelKey string) {
+	reporterEnable, tracingContextEnable := LogReporterEnable, LogTracingContextEnable
+	if !reporterEnable && !tracingContextEnable {
+		return
+	}
+	ctx := GetLogContext(reporterEnable)
+	if ctx == nil {
+		return
+	}
+	if reporterEnable {
+		reportZerologEvent(ctx, level, msg, buf, levelKey)
+	}
+	if tracingContextEnable {
+		fields := GetLogContextFields(ctx, LogTracingContextKey)
+		for i := 0; i+1 < len(fields); i += 2 {
+			e.Str(fields[i], fields[i+1])
+		}
+	}
+}
+
+func reportZerologEvent(ctx interface{}, level zerolog.Level, msg string, buf []byte, levelKey string) {
+	keys, jsonBody := LogReporterLabelKeys, LogReporterJSONBody
+	labels := make(map[string]string, len(keys))
+	if len(keys) == 0 && !jsonBody {
+		ReportLog(ctx, time.Now(), level.String(), msg, labels)
+		return
+	}
+	fields, ok := zerologEventFields(buf, levelKey)
+	if !ok {
+		// the fields cannot be decoded, only report the message
+		ReportLog(ctx, time.Now(), level.String(), msg, labels)
+		return
+	}
+	for _, key := range keys {
+		if v, ok := fields[key]; ok {
+			labels[key] = fmt.Sprintf("%v", v)
+		}
+	}
+	if jsonBody {
+		ReportStructuredLog(ctx, time.Now(), level.String(), msg, labels, fields)
+		return
+	}
+	ReportLog(ctx, time.Now(), level.String(), msg, labels)
+}
+
+// the buffer is an unclosed JSON object which contains all the fields of the event,
+// return false when the event is encoded by CBOR(built with the "binary_log" tag) or cannot be decoded
+func zerologEventFields(buf []byte, levelKey string) (map[string]interface{}, bool) {
+	fields := make(map[string]interface{})
+	if len(buf) == 0 {
+		return fields, true
+	}
+	if buf[0] != '{' {
+		return nil, false
+	}
+	data := make([]byte, 0, len(buf)+1)
+	data = append(append(data, buf...), '}')
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// keep the precision of the numbers
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, false
+	}
+	// the level is already in the tags
+	delete(fields, levelKey)
+	return fields, true
+}
+
+type ZerologAdapter struct {
+	log zerolog.Logger
+}
+
+func NewZerologAdapter(log zerolog.Logger) *ZerologAdapter {
+	return &ZerologAdapter{log: log}
+}
+
+func (l *ZerologAdapter) WithField(key string, value interface{}) interface{} {
+	return NewZerologAdapter(l.log.With().Interface(key, value).Logger())
+}
+
+func (l *ZerologAdapter) Info(args ...interface{}) {
+	l.log.Info().Msg(fmt.Sprint(args...))
+}
+
+func (l *ZerologAdapter) Infof(format string, args ...interface{}) {
+	l.log.Info().Msgf(format, args...)
+}
+
+func (l *ZerologAdapter) Warn(args ...interface{}) {
+	l.log.Warn().Msg(fmt.Sprint(args...))
+}
+
+func (l *ZerologAdapter) Warnf(format string, args ...interface{}) {
+	l.log.Warn().Msgf(format, args...)
+}
+
+func (l *ZerologAdapter) Error(args ...interface{}) {
+	l.log.Error().Msg(fmt.Sprint(args...))
+}
+
+func (l *ZerologAdapter) Errorf(format string, args ...interface{}) {
+	l.log.Error().Msgf(format, args...)
+}
@@ -0,0 +1,88 @@
+// Licensed to Apache Software Foundation (ASF) under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Apache Software Foundation (ASF) licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package frameworks
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+type reportedZerologEvent struct {
+	labels     map[string]string
+	fields     map[string]interface{}
+	structured bool
+}
+
+func setupZerologReport(t *testing.T, keys []string, jsonBody bool) *[]reportedZerologEvent {
+	oldKeys, oldJSONBody, oldReport, oldStructuredReport := LogReporterLabelKeys, LogReporterJSONBody, ReportLog, ReportStructuredLog
+	t.Cleanup(func() {
+		LogReporterLabelKeys, LogReporterJSONBody, ReportLog, ReportStructuredLog = oldKeys, oldJSONBody, oldReport, oldStructuredReport
+	})
+
+	LogReporterLabelKeys, LogReporterJSONBody = keys, jsonBody
+	reported := make([]reportedZerologEvent, 0)
+	ReportLog = func(ctx interface{}, time time.Time, level, msg string, labels map[string]string) {
+		reported = append(reported, reportedZerologEvent{labels: labels})
+	}
+	ReportStructuredLog = func(ctx interface{}, time time.Time, level, msg string, labels map[string]string,
+		fields map[string]interface{}) {
+		reported = append(reported, reportedZerologEvent{labels: labels, fields: fields, structured: true})
+	}
+	return &reported
+}
+
+func TestZerologEventFieldsJSON(t *testing.T) {
+	fields, ok := zerologEventFields([]byte(`{"level":"info","user":"alice","count":12345678901234567890`), "level")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"user": "alice", "count": json.Number("12345678901234567890")}, fields)
+
+	fields, ok = zerologEventFields(nil, "level")
+	assert.True(t, ok)
+	assert.Empty(t, fields)
+}
+
+func TestZerologEventFieldsBinary(t *testing.T) {
+	// {"user": "alice"} encoded as an indefinite length CBOR map, same as the "binary_log" build tag
+	buf := []byte{0xbf, 0x64, 'u', 's', 'e', 'r', 0x65, 'a', 'l', 'i', 'c', 'e'}
+	fields, ok := zerologEventFields(buf, "level")
+	assert.False(t, ok)
+	assert.Nil(t, fields)
+
+	fields, ok = zerologEventFields([]byte(`{"user":`), "level")
+	assert.False(t, ok)
+	assert.Nil(t, fields)
+}
+
+func TestReportZerologEvent(t *testing.T) {
+	reported := setupZerologReport(t, []string{"user"}, true)
+	reportZerologEvent(nil, zerolog.InfoLevel, "msg", []byte(`{"level":"info","user":"alice","id":1`), "level")
+	assert.Equal(t, []reportedZerologEvent{{
+		labels:     map[string]string{"user": "alice"},
+		fields:     map[string]interface{}{"user": "alice", "id": json.Number("1")},
+		structured: true,
+	}}, *reported)
+
+	// fallback to the text body without the fields when the event is encoded by CBOR
+	reported = setupZerologReport(t, []string{"user"}, true)
+	reportZerologEvent(nil, zerolog.InfoLevel, "msg", []byte{0xbf, 0x64, 'u', 's', 'e', 'r', 0x65, 'a', 'l', 'i', 'c', 'e'}, "level")
+	assert.Equal(t, []reportedZerologEvent{{labels: map[string]string{}}}, *reported)
+}
@@ -44,6 +44,9 @@ import (
 var logFrameworks = []frameworks.LogFramework{
 	frameworks.NewLogrus(),
 	frameworks.NewZap(),
+	frameworks.NewSlog(),
+	frameworks.NewZerolog(),
+	frameworks.NewStdLog(),
 }
 
 //go:embed context.go
@@ -243,10 +246,14 @@ func (i *Instrument) generateInitLoggerFileContent(pkgName string) string {
 		}
 		importsMap[name] = imp.Path.Value
 	}
-	initDecls := tools.GoStringToDecls(tools.ExecuteTemplate(string(initTmpl), struct {
+	initContent := tools.ExecuteTemplate(string(initTmpl), struct {
 		Imports                     map[string]string
 		NeedsVariables              bool
 		NeedsChangeLoggerFunc       bool
+		LoadVariablesFromAgent      bool
+		WithoutStringsImport        bool
+		CustomizedVariables         map[string]string
+		ValueFunctions              *config.GoValueFunctions
 		GetGlobalOperatorLinkMethod string
 		SetGlobalLoggerLinkMethod   string
 		OperatorTypeName            string
@@ -259,12 +266,19 @@ func (i *Instrument) generateInitLoggerFileContent(pkgName string) string {
 		LogTracingContextKeyVarName string
 		LogReporterEnableVarName    string
 		LogReporterLabelsVarName    string
+		LogReporterJSONBodyVarName  string
 		LogReportFuncName           string
+		LogReportStructuredFuncName string
+		LoadLogVariablesFuncName    string
 		InitFunctionNames           []string
 	}{
 		Imports:                     importsMap,
 		NeedsVariables:              i.packageConf.NeedsVariables,
 		NeedsChangeLoggerFunc:       i.packageConf.NeedsChangeLoggerFunc,
+		LoadVariablesFromAgent:      i.packageConf.LoadVariablesFromAgent,
+		WithoutStringsImport:        i.packageConf.WithoutStringsImport,
+		CustomizedVariables:         i.packageConf.CustomizedVariables,
+		ValueFunctions:              i.packageConf.ValueFunctions(),
 		GetGlobalOperatorLinkMethod: consts.GlobalTracerGetMethodName,
 		SetGlobalLoggerLinkMethod:   consts.GlobalLoggerSetMethodName,
 		OperatorTypeName:            "Operator",
@@ -277,9 +291,13 @@ func (i *Instrument) generateInitLoggerFileContent(pkgName string) string {
 		LogTracingContextKeyVarName: "LogTracingContextKey",
 		LogReporterEnableVarName:    "LogReporterEnable",
 		LogReporterLabelsVarName:    "LogReporterLabelKeys",
+		LogReporterJSONBodyVarName:  "LogReporterJSONBody",
 		LogReportFuncName:           "ReportLog",
+		LogReportStructuredFuncName: "ReportStructuredLog",
+		LoadLogVariablesFuncName:    "LoadLogVariables",
 		InitFunctionNames:           initFuncNames,
-	}))
+	})
+	initDecls := tools.GoStringToDecls(initContent)
 	for _, f := range initFunctions {
 		initDecls = append(initDecls, f)
 	}
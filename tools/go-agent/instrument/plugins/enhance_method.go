@@ -90,8 +90,13 @@ func NewMethodEnhance(inst instrument.Instrument, matcher *instrument.Point, f *
 		enhance.Recvs = tools.EnhanceParameterNamesWithPackagePrefix(pkgName, f.Recv, tools.FieldListTypeRecv)
 	}
 
-	importAnalyzer.AnalyzeNeedsImports(path, f.Type.Params)
-	importAnalyzer.AnalyzeNeedsImports(path, f.Type.Results)
+	// the imports of the delegator file are shared by the whole package, so the package reference could be renamed
+	for _, p := range enhance.Parameters {
+		p.Type = importAnalyzer.AnalyzeTypeImports(path, p.Type)
+	}
+	for _, r := range enhance.Results {
+		r.Type = importAnalyzer.AnalyzeTypeImports(path, r.Type)
+	}
 	enhance.importAnalyzer = importAnalyzer
 
 	enhance.FuncID = tools.BuildFuncIdentity(fullPackage, f)
@@ -551,9 +551,9 @@ func (i *Instrument) validateMethodInsMatch(matcher *instrument.EnhanceMatcher,
 }
 
 func (i *Instrument) tryToFindThePluginVersion(opts *api.CompileOptions, ins instrument.Instrument) (string, error) {
-	for _, arg := range opts.AllArgs {
+	for inx, arg := range opts.AllArgs {
 		// find the go file
-		if !strings.HasSuffix(arg, ".go") {
+		if !api.IsGoFileArg(opts.AllArgs, inx) {
 			continue
 		}
 
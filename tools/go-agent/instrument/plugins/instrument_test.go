@@ -54,6 +54,17 @@ func TestInstrument_tryToFindThePluginVersion(t *testing.T) {
 			NewTestInstrument("github.com/Shopify/sarama"),
 			"1.34.1",
 		},
+		{
+			"plugin package path with go suffix",
+			&api.CompileOptions{
+				AllArgs: []string{
+					"-p", "github.com/nats-io/nats.go",
+					"github.com/nats-io/nats.go@v1.37.0/nats.go",
+				},
+			},
+			NewTestInstrument("github.com/nats-io/nats.go"),
+			"v1.37.0",
+		},
 		{
 			"plugin for go stdlib",
 			&api.CompileOptions{
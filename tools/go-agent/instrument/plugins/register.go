@@ -20,35 +20,57 @@ package plugins
 import (
 	traceactivation "github.com/apache/skywalking-go/plugin/trace"
 	"github.com/apache/skywalking-go/plugins/amqp"
+	awssdkgov2 "github.com/apache/skywalking-go/plugins/aws-sdk-go-v2"
+	aws_dynamodb "github.com/apache/skywalking-go/plugins/aws-sdk-go-v2/service/dynamodb"
+	aws_s3 "github.com/apache/skywalking-go/plugins/aws-sdk-go-v2/service/s3"
+	aws_sns "github.com/apache/skywalking-go/plugins/aws-sdk-go-v2/service/sns"
+	aws_sqs "github.com/apache/skywalking-go/plugins/aws-sdk-go-v2/service/sqs"
+	"github.com/apache/skywalking-go/plugins/chiv5"
 	"github.com/apache/skywalking-go/plugins/core/instrument"
 	"github.com/apache/skywalking-go/plugins/dubbo"
 	"github.com/apache/skywalking-go/plugins/echov4"
+	"github.com/apache/skywalking-go/plugins/etcdv3"
 	fasthttp_client "github.com/apache/skywalking-go/plugins/fasthttp/hostclient"
 	fasthttp_router "github.com/apache/skywalking-go/plugins/fasthttp/router"
 	"github.com/apache/skywalking-go/plugins/fiber"
+	franzgo "github.com/apache/skywalking-go/plugins/franz-go"
 	"github.com/apache/skywalking-go/plugins/gin"
 	goelasticsearchv8 "github.com/apache/skywalking-go/plugins/go-elasticsearchv8"
 	goredisv9 "github.com/apache/skywalking-go/plugins/go-redisv9"
 	"github.com/apache/skywalking-go/plugins/go-restfulv3"
+	gozero "github.com/apache/skywalking-go/plugins/go-zero"
+	"github.com/apache/skywalking-go/plugins/gocql"
 	"github.com/apache/skywalking-go/plugins/goframe"
 	gorm_entry "github.com/apache/skywalking-go/plugins/gorm/entry"
 	gorm_mysql "github.com/apache/skywalking-go/plugins/gorm/mysql"
 	gorm_postgres "github.com/apache/skywalking-go/plugins/gorm/postgres"
+	graphql_gqlgen "github.com/apache/skywalking-go/plugins/graphql/gqlgen"
+	graphql_graphqlgo "github.com/apache/skywalking-go/plugins/graphql/graphqlgo"
 	"github.com/apache/skywalking-go/plugins/grpc"
+	"github.com/apache/skywalking-go/plugins/hertz"
 	"github.com/apache/skywalking-go/plugins/http"
+	"github.com/apache/skywalking-go/plugins/httprouter"
 	"github.com/apache/skywalking-go/plugins/irisv12"
+	"github.com/apache/skywalking-go/plugins/kitex"
 	"github.com/apache/skywalking-go/plugins/kratosv2"
 	"github.com/apache/skywalking-go/plugins/microv4"
 	"github.com/apache/skywalking-go/plugins/mongo"
 	"github.com/apache/skywalking-go/plugins/mux"
+	"github.com/apache/skywalking-go/plugins/nats"
 	"github.com/apache/skywalking-go/plugins/pprof"
 	"github.com/apache/skywalking-go/plugins/pulsar"
 	"github.com/apache/skywalking-go/plugins/rocketmq"
 	runtime_metrics "github.com/apache/skywalking-go/plugins/runtimemetrics"
+	"github.com/apache/skywalking-go/plugins/sarama"
 	segmentiokafka "github.com/apache/skywalking-go/plugins/segmentio-kafka"
+	sql_clickhouse "github.com/apache/skywalking-go/plugins/sql/clickhouse"
 	sql_entry "github.com/apache/skywalking-go/plugins/sql/entry"
+	sql_gosqlite3 "github.com/apache/skywalking-go/plugins/sql/gosqlite3"
+	sql_moderncsqlite "github.com/apache/skywalking-go/plugins/sql/moderncsqlite"
 	sql_mysql "github.com/apache/skywalking-go/plugins/sql/mysql"
+	sql_pgx "github.com/apache/skywalking-go/plugins/sql/pgx"
 	sql_pgxstdlib "github.com/apache/skywalking-go/plugins/sql/pgxstdlib"
+	sql_pq "github.com/apache/skywalking-go/plugins/sql/pq"
 )
 
 var instruments = make([]instrument.Instrument, 0)
@@ -74,7 +96,18 @@ func init() {
 	registerFramework(pprof.NewInstrument())
 	registerFramework(pulsar.NewInstrument())
 	registerFramework(segmentiokafka.NewInstrument())
+	registerFramework(sarama.NewInstrument())
+	registerFramework(sarama.NewShopifyInstrument())
+	registerFramework(franzgo.NewInstrument())
+	registerFramework(nats.NewInstrument())
+	registerFramework(hertz.NewInstrument())
+	registerFramework(kitex.NewInstrument())
+	registerFramework(gozero.NewInstrument())
+	registerFramework(chiv5.NewInstrument())
+	registerFramework(httprouter.NewInstrument())
 	registerFramework(goelasticsearchv8.NewInstrument())
+	registerFramework(gocql.NewInstrument())
+	registerFramework(etcdv3.NewInstrument())
 
 	// fasthttp related instruments
 	registerFramework(fasthttp_client.NewInstrument())
@@ -89,12 +122,28 @@ func init() {
 	registerFramework(sql_entry.NewInstrument())
 	registerFramework(sql_mysql.NewInstrument())
 	registerFramework(sql_pgxstdlib.NewInstrument())
+	registerFramework(sql_pgx.NewInstrument())
+	registerFramework(sql_pq.NewInstrument())
+	registerFramework(sql_gosqlite3.NewInstrument())
+	registerFramework(sql_moderncsqlite.NewInstrument())
+	registerFramework(sql_clickhouse.NewInstrument())
 
 	// echov4 related instruments
 	registerFramework(echov4.NewInstrument())
 
+	// aws-sdk-go-v2 related instruments, the service instruments must be registered before the base one
+	registerFramework(aws_s3.NewInstrument())
+	registerFramework(aws_dynamodb.NewInstrument())
+	registerFramework(aws_sqs.NewInstrument())
+	registerFramework(aws_sns.NewInstrument())
+	registerFramework(awssdkgov2.NewInstrument())
+
 	// goframe
 	registerFramework(goframe.NewInstrument())
+
+	// graphql related instruments
+	registerFramework(graphql_gqlgen.NewInstrument())
+	registerFramework(graphql_graphqlgo.NewInstrument())
 }
 
 func registerFramework(ins instrument.Instrument) {
@@ -268,6 +268,12 @@ func (c *Context) enhanceTypeNameWhenRewrite(fieldType dst.Expr, parent dst.Node
 			p.Type = generateExpr()
 		case *dst.ArrayType:
 			p.Elt = generateExpr()
+		case *dst.MapType:
+			if argIndex == 0 {
+				p.Key = generateExpr()
+			} else {
+				p.Value = generateExpr()
+			}
 		case *dst.ValueSpec:
 			p.Type = generateExpr()
 		case *dst.BinaryExpr:
@@ -288,6 +294,8 @@ func (c *Context) enhanceTypeNameWhenRewrite(fieldType dst.Expr, parent dst.Node
 		return c.enhanceTypeNameWhenRewrite(t.X, t, -1)
 	case *dst.ArrayType:
 		return c.enhanceTypeNameWhenRewrite(t.Elt, t, -1)
+	case *dst.ChanType:
+		return c.enhanceTypeNameWhenRewrite(t.Value, t, -1)
 	case *dst.Ellipsis:
 		return c.enhanceTypeNameWhenRewrite(t.Elt, t, -1)
 	case *dst.CompositeLit:
@@ -335,8 +343,8 @@ func (c *Context) enhanceTypeNameWhenRewrite(fieldType dst.Expr, parent dst.Node
 	case *dst.ParenExpr:
 		c.rewriteVarIfExistingMapping(t.X, t)
 	case *dst.MapType:
-		c.enhanceTypeNameWhenRewrite(t.Key, t, -1)
-		c.enhanceTypeNameWhenRewrite(t.Value, t, -1)
+		c.enhanceTypeNameWhenRewrite(t.Key, t, 0)
+		c.enhanceTypeNameWhenRewrite(t.Value, t, 1)
 	}
 
 	return "", ""
@@ -359,7 +367,7 @@ func (c *Context) alreadyGenerated(name string) bool {
 
 func (c *Context) callIsBasicNamesOrEnhanceName(name string) bool {
 	return strings.HasPrefix(name, OperatePrefix) || strings.HasPrefix(name, GenerateMethodPrefix) ||
-		name == "make" || name == "recover" || name == "len"
+		name == "make" || name == "recover" || name == "len" || name == "cap" || name == "close"
 }
 
 func (r *rewriteImportInfo) generateStaticMethod(name string) *dst.Ident {
@@ -254,6 +254,8 @@ func (c *Context) rewriteVarIfExistingMapping(exp, parent dst.Expr) bool {
 		}
 	case *dst.StarExpr:
 		c.enhanceTypeNameWhenRewrite(n.X, n, -1)
+	case *dst.MapType:
+		c.enhanceTypeNameWhenRewrite(n, parent, -1)
 	case *dst.FuncLit:
 		c.rewriteMapping.pushBlockStack()
 		c.enhanceFuncParameter(n.Type.Params)
@@ -25,10 +25,13 @@ import (
 	"go/token"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/apache/skywalking-go/tools/go-agent/instrument/consts"
 
@@ -240,12 +243,16 @@ func BuildFuncIdentity(pkgPath string, node *dst.FuncDecl) string {
 type ImportAnalyzer struct {
 	imports     map[string]map[string]*dst.ImportSpec
 	usedImports map[string]*dst.ImportSpec
+	// usedNames is the reference name of the used import path,
+	// the files in the same package could import different packages with the same name
+	usedNames map[string]string
 }
 
 func CreateImportAnalyzer() *ImportAnalyzer {
 	return &ImportAnalyzer{
 		imports:     make(map[string]map[string]*dst.ImportSpec),
-		usedImports: make(map[string]*dst.ImportSpec)}
+		usedImports: make(map[string]*dst.ImportSpec),
+		usedNames:   make(map[string]string)}
 }
 
 func (i *ImportAnalyzer) AnalyzeFileImports(filePath string, f dst.Node) {
@@ -256,27 +263,57 @@ func (i *ImportAnalyzer) AnalyzeFileImports(filePath string, f dst.Node) {
 		if !ok {
 			return true
 		}
-		var pkgName = filepath.Base(importSpec.Path.Value)
+		var pkgName string
 		if importSpec.Name != nil {
 			pkgName = importSpec.Name.Name
+		} else {
+			pkgName = ImportPathToAssumedName(strings.Trim(importSpec.Path.Value, "\""))
 		}
-		imports[strings.Trim(pkgName, "\"")] = importSpec
+		imports[pkgName] = importSpec
 		return false
 	}, func(cursor *dstutil.Cursor) bool {
 		return true
 	})
 }
 
+// ImportPathToAssumedName returns the package name which is usually used by the import path,
+// such as "github.com/nats-io/nats.go" is "nats", "github.com/redis/go-redis/v9" is "redis".
+func ImportPathToAssumedName(importPath string) string {
+	base := path.Base(importPath)
+	if strings.HasPrefix(base, "v") {
+		if _, err := strconv.Atoi(base[1:]); err == nil {
+			if dir := path.Dir(importPath); dir != "." {
+				base = path.Base(dir)
+			}
+		}
+	}
+	base = strings.TrimPrefix(base, "go-")
+	if i := strings.IndexFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}); i >= 0 {
+		base = base[:i]
+	}
+	return base
+}
+
 func (i *ImportAnalyzer) AnalyzeNeedsImports(filePath string, fields *dst.FieldList) {
 	if fields == nil || len(fields.List) == 0 {
 		return
 	}
 
 	for _, f := range fields.List {
-		i.analyzeFieldImport(filePath, f.Type)
+		i.analyzeFieldImport(filePath, dst.Clone(f.Type).(dst.Expr))
 	}
 }
 
+// AnalyzeTypeImports analyzes the imports used by the type, and returns the cloned type.
+// If the package name is already used by another import path, the package reference is renamed to an alias.
+func (i *ImportAnalyzer) AnalyzeTypeImports(filePath string, tp dst.Expr) dst.Expr {
+	result := dst.Clone(tp).(dst.Expr)
+	i.analyzeFieldImport(filePath, result)
+	return result
+}
+
 func (i *ImportAnalyzer) analyzeFieldImport(filePath string, exp dst.Expr) {
 	switch n := exp.(type) {
 	case *dst.Ident:
@@ -294,7 +331,7 @@ func (i *ImportAnalyzer) analyzeFieldImport(filePath string, exp dst.Expr) {
 		if spec == nil {
 			return
 		}
-		i.usedImports[pkgRefName.Name] = spec
+		pkgRefName.Name = i.useImport(pkgRefName.Name, spec)
 	case *dst.Ellipsis:
 		i.analyzeFieldImport(filePath, n.Elt)
 	case *dst.ArrayType:
@@ -303,7 +340,40 @@ func (i *ImportAnalyzer) analyzeFieldImport(filePath string, exp dst.Expr) {
 		i.analyzeFieldImport(filePath, n.X)
 	case *dst.ChanType:
 		i.analyzeFieldImport(filePath, n.Value)
+	case *dst.MapType:
+		i.analyzeFieldImport(filePath, n.Key)
+		i.analyzeFieldImport(filePath, n.Value)
+	case *dst.FuncType:
+		i.analyzeFieldListImport(filePath, n.Params)
+		i.analyzeFieldListImport(filePath, n.Results)
+	}
+}
+
+func (i *ImportAnalyzer) analyzeFieldListImport(filePath string, fields *dst.FieldList) {
+	if fields == nil {
+		return
+	}
+	for _, f := range fields.List {
+		i.analyzeFieldImport(filePath, f.Type)
+	}
+}
+
+// useImport records the import is used and returns the reference name of it
+func (i *ImportAnalyzer) useImport(name string, spec *dst.ImportSpec) string {
+	if used, ok := i.usedNames[spec.Path.Value]; ok {
+		return used
+	}
+	refName := name
+	for index := 1; i.usedImports[refName] != nil; index++ {
+		refName = fmt.Sprintf("%s%d", name, index)
+	}
+	if refName != name {
+		spec = dst.Clone(spec).(*dst.ImportSpec)
+		spec.Name = dst.NewIdent(refName)
 	}
+	i.usedImports[refName] = spec
+	i.usedNames[spec.Path.Value] = refName
+	return refName
 }
 
 func (i *ImportAnalyzer) AppendUsedImports(decl *dst.GenDecl) {
@@ -214,6 +214,23 @@ func TestImportAnalyzer(t *testing.T) {
 				"test2": "test/test2",
 			},
 		},
+		{
+			imports:    []string{`"github.com/nats-io/nats.go"`, `"github.com/redis/go-redis/v9"`},
+			fieldsCode: `v1 *nats.Msg, v2 chan *redis.Message`,
+			usedImports: map[string]string{
+				"nats":  "github.com/nats-io/nats.go",
+				"redis": "github.com/redis/go-redis/v9",
+			},
+		},
+		{
+			imports:    []string{`test1 "test/test1"`, `test2 "test/test2"`, `test3 "test/test3"`},
+			fieldsCode: `v1 func(test1.Int) test2.Int, v2 map[string]*test3.Int`,
+			usedImports: map[string]string{
+				"test1": "test/test1",
+				"test2": "test/test2",
+				"test3": "test/test3",
+			},
+		},
 	}
 
 	for i, test := range tests {
@@ -245,3 +262,39 @@ func TestImportAnalyzer(t *testing.T) {
 		}
 	}
 }
+
+func TestImportAnalyzerWithSamePackageName(t *testing.T) {
+	analyzer := CreateImportAnalyzer()
+	types := make([]string, 0)
+	for _, f := range []struct {
+		path    string
+		content string
+	}{
+		{path: "conn.go", content: "import \"test/lib/driver\"\nfunc test(v1 driver.Conn) {}"},
+		{path: "std.go", content: "import \"database/sql/driver\"\nfunc test(v1 driver.Conn, v2 []driver.Value) {}"},
+		{path: "rows.go", content: "import \"test/lib/driver\"\nfunc test(v1 driver.Rows) {}"},
+	} {
+		file := &dst.File{
+			Name:  dst.NewIdent(f.path),
+			Decls: GoStringToDecls(f.content),
+		}
+		analyzer.AnalyzeFileImports(f.path, file)
+		funcDecl := file.Decls[len(file.Decls)-1].(*dst.FuncDecl)
+		for _, param := range funcDecl.Type.Params.List {
+			types = append(types, GenerateTypeNameByExp(analyzer.AnalyzeTypeImports(f.path, param.Type)))
+		}
+		if name := GenerateTypeNameByExp(funcDecl.Type.Params.List[0].Type); !strings.HasPrefix(name, "driver.") {
+			t.Fatalf("the original type should not be changed, actual %s", name)
+		}
+	}
+
+	if expected := []string{"driver.Conn", "driver1.Conn", "[]driver1.Value", "driver.Rows"}; !reflect.DeepEqual(expected, types) {
+		t.Fatalf("expect types %v, actual %v", expected, types)
+	}
+	if spec := analyzer.usedImports["driver"]; spec == nil || spec.Path.Value != `"test/lib/driver"` || spec.Name != nil {
+		t.Fatalf("expect use test/lib/driver as driver, actual %v", spec)
+	}
+	if spec := analyzer.usedImports["driver1"]; spec == nil || spec.Path.Value != `"database/sql/driver"` || spec.Name.Name != "driver1" {
+		t.Fatalf("expect use database/sql/driver as driver1, actual %v", spec)
+	}
+}
@@ -167,6 +167,8 @@ func GenerateTypeNameByExp(exp dst.Expr) string {
 		data = "[]" + GenerateTypeNameByExp(n.Elt)
 	case *dst.ArrayType:
 		data = "[]" + GenerateTypeNameByExp(n.Elt)
+	case *dst.MapType:
+		data = "map[" + GenerateTypeNameByExp(n.Key) + "]" + GenerateTypeNameByExp(n.Value)
 	case *dst.FuncType:
 		data = "func("
 		if n.Params != nil && len(n.Params.List) > 0 {
@@ -223,6 +225,10 @@ func addPackagePrefixForArgsAndClone(pkg string, tp dst.Expr) dst.Expr {
 		expr := dst.Clone(tp).(*dst.Ellipsis)
 		expr.Elt = addPackagePrefixForArgsAndClone(pkg, t.Elt)
 		return expr
+	case *dst.ArrayType:
+		expr := dst.Clone(tp).(*dst.ArrayType)
+		expr.Elt = addPackagePrefixForArgsAndClone(pkg, t.Elt)
+		return expr
 	case *dst.SelectorExpr:
 		exp := dst.Clone(tp).(*dst.SelectorExpr)
 		// if also contains a package prefix, then it could be reffed a package with same name
@@ -234,6 +240,23 @@ func addPackagePrefixForArgsAndClone(pkg string, tp dst.Expr) dst.Expr {
 		return exp
 	case *dst.ChanType:
 		expr := dst.Clone(tp).(*dst.ChanType)
+		expr.Value = addPackagePrefixForArgsAndClone(pkg, t.Value)
+		return expr
+	case *dst.MapType:
+		expr := dst.Clone(tp).(*dst.MapType)
+		expr.Key = addPackagePrefixForArgsAndClone(pkg, t.Key)
+		expr.Value = addPackagePrefixForArgsAndClone(pkg, t.Value)
+		return expr
+	case *dst.FuncType:
+		expr := dst.Clone(tp).(*dst.FuncType)
+		for _, list := range []*dst.FieldList{expr.Params, expr.Results} {
+			if list == nil {
+				continue
+			}
+			for _, field := range list.List {
+				field.Type = addPackagePrefixForArgsAndClone(pkg, field.Type)
+			}
+		}
 		return expr
 	default:
 		return dst.Clone(tp).(dst.Expr)
@@ -109,6 +109,23 @@ func TestEnhanceParameterNamesMultiParams(t *testing.T) {
 	validateParameterTestList(t, tests)
 }
 
+func TestEnhanceParameterNamesWithPackagePrefix(t *testing.T) {
+	fun := GoStringToDecls(`func (p *producer) Send(msg *Message, msgs []*Message, keys []string, cb func(*Message, error), ch chan *Message, headers map[string]*Header, vars map[string]interface{}, opts ...Option) error {
+		return nil
+	}`)[0].(*dst.FuncDecl)
+	params := EnhanceParameterNamesWithPackagePrefix("kafka", fun.Type.Params, FieldListTypeParam)
+	excepted := []string{"*kafka.Message", "[]*kafka.Message", "[]string", "func(*kafka.Message, error)", "chan *kafka.Message",
+		"map[string]*kafka.Header", "map[string]interface{}", "[]kafka.Option"}
+	if len(params) != len(excepted) {
+		t.Fatalf("expected count %d, actual %d", len(excepted), len(params))
+	}
+	for i, exp := range excepted {
+		if actual := params[i].PackagedTypeName(); actual != exp {
+			t.Errorf("case %d: expected type %s, actual %s", i, exp, actual)
+		}
+	}
+}
+
 func validateParameterTestList(t *testing.T, tests []TestEnhanceParameterInfo) {
 	for i, test := range tests {
 		fun := GoStringToDecls(test.funcCode)[0].(*dst.FuncDecl)